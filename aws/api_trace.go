@@ -0,0 +1,323 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// apiTraceIDParam matches the names of the request parameters that identify
+// the resources an API call acts on. Only these parameters are written to the
+// API trace, so that parameter values such as passwords, secrets, policies
+// or templates are never written to it.
+var apiTraceIDParam = regexp.MustCompile(`(Arn|ARN|Id|Name|Identifier|Url)s?$|^Bucket$`)
+
+// apiTraceSensitiveParam matches the names of the request parameters left out
+// of the API trace even though they look like identifiers, such as the
+// PresignedUrl of cross-region copies, which is signed with the credentials.
+// The SecretId and SecretArn identifying Secrets Manager secrets are kept.
+var apiTraceSensitiveParam = regexp.MustCompile(`(?i)presigned|signature|token|password|secret(?:Access|Key|String|Value)`)
+
+const apiTraceHandlerName = "terraform.APITrace"
+
+// apiTraceEntry is the JSON line written to the API trace for each API call.
+type apiTraceEntry struct {
+	Time       string                 `json:"time"`
+	Service    string                 `json:"service"`
+	Operation  string                 `json:"operation"`
+	Address    string                 `json:"address,omitempty"`
+	ResourceID string                 `json:"resource_id,omitempty"`
+	DurationMs int64                  `json:"duration_ms"`
+	Retries    int                    `json:"retries"`
+	ErrorCode  string                 `json:"error_code,omitempty"`
+	RequestID  string                 `json:"request_id,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"`
+}
+
+// apiTracer writes one JSON line per AWS API call, once the call and all its
+// retries have completed.
+type apiTracer struct {
+	mu sync.Mutex
+	w  io.Writer
+
+	now func() time.Time
+}
+
+func newAPITracer(w io.Writer) *apiTracer {
+	return &apiTracer{
+		w:   w,
+		now: time.Now,
+	}
+}
+
+// openAPITraceFile returns a tracer appending to the file at path, which is
+// created if it does not exist.
+func openAPITraceFile(path string) (*apiTracer, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("api_trace_file: error opening %s: %s", path, err)
+	}
+	return newAPITracer(f), nil
+}
+
+// addHandlers adds the handler tracing the API calls made with h.
+func (t *apiTracer) addHandlers(h *request.Handlers) {
+	h.Complete.PushBackNamed(t.handler(""))
+}
+
+// handler returns the handler tracing API calls made for the Terraform
+// resource at address, if any.
+func (t *apiTracer) handler(address string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: apiTraceHandlerName,
+		Fn: func(r *request.Request) {
+			t.trace(r, address)
+		},
+	}
+}
+
+func (t *apiTracer) trace(r *request.Request, address string) {
+	now := t.now()
+	entry := &apiTraceEntry{
+		Time:       now.UTC().Format(time.RFC3339Nano),
+		Service:    r.ClientInfo.ServiceName,
+		Operation:  r.Operation.Name,
+		Address:    address,
+		ResourceID: apiTraceResourceID(r.Params),
+		DurationMs: int64(now.Sub(r.Time) / time.Millisecond),
+		Retries:    r.RetryCount,
+		RequestID:  r.RequestID,
+	}
+	if r.Error != nil {
+		entry.ErrorCode = "error"
+		if err, ok := r.Error.(awserr.Error); ok {
+			entry.ErrorCode = err.Code()
+		}
+	}
+	if params := apiTraceParams(r.Params); len(params) > 0 {
+		entry.Params = params
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		log.Printf("[WARN] Error encoding API trace of %s %s: %s", entry.Service, entry.Operation, err)
+		return
+	}
+
+	// Each line is written at once so that the traces of concurrent calls,
+	// and of providers sharing the file, do not interleave.
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, err := t.w.Write(append(b, '\n')); err != nil {
+		log.Printf("[WARN] Error writing API trace of %s %s: %s", entry.Service, entry.Operation, err)
+	}
+}
+
+// apiTraceParam returns whether the request parameter called name is written
+// to the API trace.
+func apiTraceParam(name string) bool {
+	return apiTraceIDParam.MatchString(name) && !apiTraceSensitiveParam.MatchString(name)
+}
+
+// apiTraceResourceID returns the identifier of the resource an API call acts
+// on: the first of the top-level request parameters that names one.
+func apiTraceResourceID(params interface{}) string {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" || !apiTraceParam(field.Name) {
+			continue
+		}
+		if s, ok := v.Field(i).Interface().(*string); ok && s != nil && *s != "" {
+			return *s
+		}
+	}
+
+	return ""
+}
+
+// apiTraceParams returns the top-level request parameters of an API call
+// that identify resources, such as InstanceIds or RoleName. All the other
+// parameters are left out.
+func apiTraceParams(params interface{}) map[string]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	m := make(map[string]interface{})
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" || !apiTraceParam(field.Name) {
+			continue
+		}
+		switch value := v.Field(i).Interface().(type) {
+		case *string:
+			if value != nil {
+				m[field.Name] = *value
+			}
+		case []*string:
+			if value != nil {
+				m[field.Name] = aws.StringValueSlice(value)
+			}
+		}
+	}
+
+	return m
+}
+
+// withAPITraceAddress returns a copy of c whose API calls are traced along
+// with address, the address of the Terraform resource they are made for, or
+// c itself when API calls are not traced.
+//
+// Each service client is copied with its own trace handler, since the clients
+// of c are shared by the resources Terraform manages concurrently.
+func (c *AWSClient) withAPITraceAddress(address string) *AWSClient {
+	if c.apiTracer == nil || address == "" {
+		return c
+	}
+
+	handler := c.apiTracer.handler(address)
+	conns := *c
+	conns.apiTraceAddress = address
+	conns.apiTraceBase = c
+
+	v := reflect.ValueOf(&conns).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		// The service clients are unexported fields, which are only settable
+		// through their address.
+		field := reflect.NewAt(v.Field(i).Type(), unsafe.Pointer(v.Field(i).UnsafeAddr())).Elem()
+		if field.Kind() != reflect.Ptr || field.IsNil() || field.Elem().Kind() != reflect.Struct {
+			continue
+		}
+		embedded := field.Elem().FieldByName("Client")
+		if !embedded.IsValid() {
+			continue
+		}
+		svcClient, ok := embedded.Interface().(*client.Client)
+		if !ok || svcClient == nil {
+			continue
+		}
+
+		traced := *svcClient
+		traced.Handlers = svcClient.Handlers.Copy()
+		traced.Handlers.Complete.Swap(apiTraceHandlerName, handler)
+
+		svc := reflect.New(field.Type().Elem())
+		svc.Elem().Set(field.Elem())
+		svc.Elem().FieldByName("Client").Set(reflect.ValueOf(&traced))
+		field.Set(svc)
+	}
+
+	return &conns
+}
+
+// apiTraceProvider records in the API trace the address of the Terraform
+// resource or data source each AWS API call is made for, which helper/schema
+// does not pass on to resources, by giving each of them its own copy of the
+// AWSClient.
+type apiTraceProvider struct {
+	*schema.Provider
+}
+
+// APITracedProvider returns the provider served by the plugin: Provider, with
+// the addresses of Terraform resources recorded in the API trace.
+func APITracedProvider() terraform.ResourceProvider {
+	return &apiTraceProvider{Provider().(*schema.Provider)}
+}
+
+// meta returns the meta of the provider to use for the resource described by
+// info.
+func (p *apiTraceProvider) meta(info *terraform.InstanceInfo) interface{} {
+	client, ok := p.Meta().(*AWSClient)
+	if !ok {
+		return p.Meta()
+	}
+	return client.withAPITraceAddress(info.HumanId())
+}
+
+func (p *apiTraceProvider) Apply(info *terraform.InstanceInfo, s *terraform.InstanceState, d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+	r, ok := p.ResourcesMap[info.Type]
+	if !ok {
+		return p.Provider.Apply(info, s, d)
+	}
+	return r.Apply(s, d, p.meta(info))
+}
+
+func (p *apiTraceProvider) Diff(info *terraform.InstanceInfo, s *terraform.InstanceState, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	r, ok := p.ResourcesMap[info.Type]
+	if !ok {
+		return p.Provider.Diff(info, s, c)
+	}
+	return r.Diff(s, c, p.meta(info))
+}
+
+func (p *apiTraceProvider) Refresh(info *terraform.InstanceInfo, s *terraform.InstanceState) (*terraform.InstanceState, error) {
+	r, ok := p.ResourcesMap[info.Type]
+	if !ok {
+		return p.Provider.Refresh(info, s)
+	}
+	return r.Refresh(s, p.meta(info))
+}
+
+func (p *apiTraceProvider) ReadDataDiff(info *terraform.InstanceInfo, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	r, ok := p.DataSourcesMap[info.Type]
+	if !ok {
+		return p.Provider.ReadDataDiff(info, c)
+	}
+	return r.Diff(nil, c, p.meta(info))
+}
+
+func (p *apiTraceProvider) ReadDataApply(info *terraform.InstanceInfo, d *terraform.InstanceDiff) (*terraform.InstanceState, error) {
+	r, ok := p.DataSourcesMap[info.Type]
+	if !ok {
+		return p.Provider.ReadDataApply(info, d)
+	}
+	return r.ReadDataApply(d, p.meta(info))
+}
+
+// ImportState mirrors schema.Provider.ImportState, with the meta of the
+// imported resource.
+func (p *apiTraceProvider) ImportState(info *terraform.InstanceInfo, id string) ([]*terraform.InstanceState, error) {
+	r, ok := p.ResourcesMap[info.Type]
+	if !ok || r.Importer == nil || r.Importer.State == nil {
+		return p.Provider.ImportState(info, id)
+	}
+
+	data := r.Data(nil)
+	data.SetId(id)
+	data.SetType(info.Type)
+
+	results, err := r.Importer.State(data, p.meta(info))
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*terraform.InstanceState, len(results))
+	for i, result := range results {
+		states[i] = result.State()
+		if states[i] == nil {
+			return nil, fmt.Errorf("nil entry in ImportState results of %s, this is always a bug with the resource", info.Type)
+		}
+	}
+
+	return states, nil
+}
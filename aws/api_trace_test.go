@@ -0,0 +1,380 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAPITracer(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("x-amzn-RequestId", fmt.Sprintf("request-%d", calls))
+		switch calls {
+		case 1:
+			// Retried once, then succeeds
+			w.WriteHeader(500)
+			w.Write([]byte(`<ErrorResponse><Error><Code>InternalError</Code><Message>oops</Message></Error></ErrorResponse>`))
+		case 2:
+			w.Write([]byte(`<GetUserResponse><GetUserResult><User><UserName>test</UserName></User></GetUserResult></GetUserResponse>`))
+		default:
+			w.WriteHeader(404)
+			w.Write([]byte(`<ErrorResponse><Error><Code>NoSuchEntity</Code><Message>not found</Message></Error></ErrorResponse>`))
+		}
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(ts.URL),
+		MaxRetries:  aws.Int(1),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	var buf bytes.Buffer
+	tracer := newAPITracer(&buf)
+	tracer.addHandlers(&sess.Handlers)
+	conn := iam.New(sess)
+
+	if _, err := conn.GetUser(&iam.GetUserInput{UserName: aws.String("test")}); err != nil {
+		t.Fatalf("error calling GetUser: %s", err)
+	}
+	if _, err := conn.CreateLoginProfile(&iam.CreateLoginProfileInput{
+		UserName: aws.String("test"),
+		Password: aws.String("hunter2"),
+	}); err == nil {
+		t.Fatal("expected CreateLoginProfile to fail")
+	}
+
+	if strings.Contains(buf.String(), "hunter2") {
+		t.Fatalf("expected password to be left out, got: %s", buf.String())
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 trace lines, got %d: %s", len(lines), buf.String())
+	}
+
+	var entries []apiTraceEntry
+	for _, line := range lines {
+		var entry apiTraceEntry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("error decoding trace line %q: %s", line, err)
+		}
+		if _, err := time.Parse(time.RFC3339Nano, entry.Time); err != nil {
+			t.Errorf("expected an RFC 3339 time, got %q", entry.Time)
+		}
+		if entry.DurationMs < 0 {
+			t.Errorf("expected a duration, got %d", entry.DurationMs)
+		}
+		entry.Time = ""
+		entry.DurationMs = 0
+		entries = append(entries, entry)
+	}
+
+	expected := []apiTraceEntry{
+		{
+			Service:    "iam",
+			Operation:  "GetUser",
+			ResourceID: "test",
+			Retries:    1,
+			RequestID:  "request-2",
+			Params:     map[string]interface{}{"UserName": "test"},
+		},
+		{
+			Service:    "iam",
+			Operation:  "CreateLoginProfile",
+			ResourceID: "test",
+			ErrorCode:  "NoSuchEntity",
+			RequestID:  "request-3",
+			Params:     map[string]interface{}{"UserName": "test"},
+		},
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("bad: %s", spew.Sdump(entries))
+	}
+}
+
+func TestAPITracer_putParameter(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"Version":1}`))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(ts.URL),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	var buf bytes.Buffer
+	newAPITracer(&buf).addHandlers(&sess.Handlers)
+
+	_, err = ssm.New(sess).PutParameter(&ssm.PutParameterInput{
+		Name:  aws.String("/app/db_password"),
+		Type:  aws.String(ssm.ParameterTypeSecureString),
+		Value: aws.String("hunter2"),
+	})
+	if err != nil {
+		t.Fatalf("error calling PutParameter: %s", err)
+	}
+
+	if strings.Contains(buf.String(), "hunter2") {
+		t.Fatalf("expected the parameter value to be left out, got: %s", buf.String())
+	}
+
+	var entry apiTraceEntry
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("error decoding trace %q: %s", buf.String(), err)
+	}
+	expected := map[string]interface{}{"Name": "/app/db_password"}
+	if entry.ResourceID != "/app/db_password" || !reflect.DeepEqual(entry.Params, expected) {
+		t.Fatalf("bad: %s", spew.Sdump(entry))
+	}
+}
+
+func TestAPITraceParams(t *testing.T) {
+	cases := []struct {
+		Params   interface{}
+		Expected map[string]interface{}
+	}{
+		{
+			Params: &ec2.RunInstancesInput{
+				ImageId:  aws.String("ami-12345678"),
+				MinCount: aws.Int64(1),
+				UserData: aws.String("IyEvYmluL2Jhc2gK"),
+				SecurityGroupIds: []*string{
+					aws.String("sg-1"),
+					aws.String("sg-2"),
+				},
+			},
+			Expected: map[string]interface{}{
+				"ImageId":          "ami-12345678",
+				"SecurityGroupIds": []string{"sg-1", "sg-2"},
+			},
+		},
+		{
+			Params: &secretsmanager.PutSecretValueInput{
+				SecretId:     aws.String("example"),
+				SecretString: aws.String("s3cr3t"),
+				SecretBinary: []byte("s3cr3t"),
+			},
+			Expected: map[string]interface{}{
+				"SecretId": "example",
+			},
+		},
+		{
+			Params: &kms.EncryptInput{
+				KeyId:     aws.String("alias/example"),
+				Plaintext: []byte("s3cr3t"),
+			},
+			Expected: map[string]interface{}{
+				"KeyId": "alias/example",
+			},
+		},
+		{
+			Params: &rds.CreateDBInstanceInput{
+				DBInstanceIdentifier: aws.String("db"),
+				MasterUserPassword:   aws.String("hunter2"),
+				Tags: []*rds.Tag{
+					{Key: aws.String("Name"), Value: aws.String("db")},
+				},
+			},
+			Expected: map[string]interface{}{
+				"DBInstanceIdentifier": "db",
+			},
+		},
+		{
+			Params: &lambda.UpdateFunctionConfigurationInput{
+				FunctionName: aws.String("fn"),
+				Environment: &lambda.Environment{
+					Variables: map[string]*string{
+						"DB_HOST": aws.String("db.example.com"),
+					},
+				},
+			},
+			Expected: map[string]interface{}{
+				"FunctionName": "fn",
+			},
+		},
+		{
+			Params: &iam.PutRolePolicyInput{
+				RoleName:       aws.String("role"),
+				PolicyName:     aws.String("policy"),
+				PolicyDocument: aws.String(`{"Version":"2012-10-17"}`),
+			},
+			Expected: map[string]interface{}{
+				"RoleName":   "role",
+				"PolicyName": "policy",
+			},
+		},
+		{
+			Params: &ec2.CopySnapshotInput{
+				SourceRegion:     aws.String("us-west-2"),
+				SourceSnapshotId: aws.String("snap-12345678"),
+				PresignedUrl:     aws.String("https://ec2.us-west-2.amazonaws.com/?Action=CopySnapshot&X-Amz-Security-Token=s3cr3t&X-Amz-Signature=s3cr3t"),
+			},
+			Expected: map[string]interface{}{
+				"SourceSnapshotId": "snap-12345678",
+			},
+		},
+		{
+			Params: &rds.CreateDBInstanceReadReplicaInput{
+				DBInstanceIdentifier:       aws.String("replica"),
+				SourceDBInstanceIdentifier: aws.String("arn:aws:rds:us-west-2:123456789012:db:db"),
+				PreSignedUrl:               aws.String("https://rds.us-west-2.amazonaws.com/?Action=CreateDBInstanceReadReplica&X-Amz-Signature=s3cr3t"),
+			},
+			Expected: map[string]interface{}{
+				"DBInstanceIdentifier":       "replica",
+				"SourceDBInstanceIdentifier": "arn:aws:rds:us-west-2:123456789012:db:db",
+			},
+		},
+		{
+			Params:   &ec2.DescribeVpcsInput{},
+			Expected: map[string]interface{}{},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := apiTraceParams(tc.Params)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %s", i, spew.Sdump(actual))
+		}
+	}
+}
+
+func TestAPITraceResourceID(t *testing.T) {
+	cases := []struct {
+		Params   interface{}
+		Expected string
+	}{
+		{&ec2.DescribeVpcsInput{}, ""},
+		{&ec2.DeleteVpcInput{VpcId: aws.String("vpc-12345678")}, "vpc-12345678"},
+		{&iam.GetRoleInput{RoleName: aws.String("role")}, "role"},
+		{&sqs.GetQueueAttributesInput{QueueUrl: aws.String("https://sqs.us-east-1.amazonaws.com/123456789012/queue")}, "https://sqs.us-east-1.amazonaws.com/123456789012/queue"},
+		{&rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String("db")}, "db"},
+		{&rds.CopyDBClusterSnapshotInput{PreSignedUrl: aws.String("https://rds.us-west-2.amazonaws.com/?X-Amz-Signature=s3cr3t")}, ""},
+		{&secretsmanager.DescribeSecretInput{SecretId: aws.String("arn:aws:secretsmanager:us-east-1:123456789012:secret:example")}, "arn:aws:secretsmanager:us-east-1:123456789012:secret:example"},
+		{nil, ""},
+	}
+
+	for i, tc := range cases {
+		if actual := apiTraceResourceID(tc.Params); actual != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAPITracer_address(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<GetUserResponse><GetUserResult><User><UserName>test</UserName></User></GetUserResult></GetUserResponse>`))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Region:      aws.String("us-east-1"),
+		Endpoint:    aws.String(ts.URL),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	var buf bytes.Buffer
+	tracer := newAPITracer(&buf)
+	tracer.addHandlers(&sess.Handlers)
+	shared := &AWSClient{
+		apiTracer: tracer,
+		iamconn:   iam.New(sess),
+		region:    "us-east-1",
+	}
+
+	client := shared.withAPITraceAddress("module.app.aws_iam_user.test")
+	if client.iamconn == shared.iamconn {
+		t.Fatal("expected the IAM client to be copied")
+	}
+	if client.region != "us-east-1" || client.apiTraceBase != shared {
+		t.Fatalf("bad: %s", spew.Sdump(client))
+	}
+
+	for _, conn := range []*iam.IAM{client.iamconn, shared.iamconn} {
+		if _, err := conn.GetUser(&iam.GetUserInput{UserName: aws.String("test")}); err != nil {
+			t.Fatalf("error calling GetUser: %s", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 trace lines, got %d: %s", len(lines), buf.String())
+	}
+	for i, expected := range []string{"module.app.aws_iam_user.test", ""} {
+		var entry apiTraceEntry
+		if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil {
+			t.Fatalf("error decoding trace line %q: %s", lines[i], err)
+		}
+		if entry.Address != expected {
+			t.Errorf("%d: expected address %q, got %q", i, expected, entry.Address)
+		}
+	}
+
+	if untraced := (&AWSClient{iamconn: shared.iamconn}).withAPITraceAddress("aws_iam_user.test"); untraced.iamconn != shared.iamconn {
+		t.Fatal("expected clients not to be copied when API calls are not traced")
+	}
+}
+
+func TestAPITraceProvider(t *testing.T) {
+	var address string
+	p := &apiTraceProvider{&schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"aws_test": {
+				Schema: map[string]*schema.Schema{},
+				Read: func(d *schema.ResourceData, meta interface{}) error {
+					address = meta.(*AWSClient).apiTraceAddress
+					return nil
+				},
+			},
+		},
+	}}
+	p.SetMeta(&AWSClient{apiTracer: newAPITracer(&bytes.Buffer{})})
+
+	info := &terraform.InstanceInfo{
+		Id:         "aws_test.example",
+		ModulePath: []string{"root", "app"},
+		Type:       "aws_test",
+	}
+	if _, err := p.Refresh(info, &terraform.InstanceState{ID: "example"}); err != nil {
+		t.Fatalf("error refreshing: %s", err)
+	}
+
+	if expected := "module.app.aws_test.example"; address != expected {
+		t.Fatalf("expected address %q, got %q", expected, address)
+	}
+}
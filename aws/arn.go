@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+// parseArnResource parses s as an ARN and splits its resource into the type
+// of the resource and its ID, on the first slash or colon, e.g. role and
+// path/name in arn:aws:iam::123456789012:role/path/name, or function and
+// name:qualifier in arn:aws:lambda:us-east-1:123456789012:function:name:qualifier.
+// The type is empty for resources without one, such as S3 buckets and SNS
+// topics.
+func parseArnResource(s string) (arn.ARN, string, string, error) {
+	a, err := arn.Parse(s)
+	if err != nil {
+		return arn.ARN{}, "", "", err
+	}
+
+	i := strings.IndexAny(a.Resource, "/:")
+	if i < 0 {
+		return a, "", a.Resource, nil
+	}
+	return a, a.Resource[:i], a.Resource[i+1:], nil
+}
+
+// arnResourceName returns the name of the resource of ARN s, without the type
+// or path of the resource, e.g. name in arn:aws:iam::123456789012:role/path/name.
+func arnResourceName(s string) (string, error) {
+	_, _, id, err := parseArnResource(s)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", fmt.Errorf("ARN %q has no resource name", s)
+	}
+	return id[strings.LastIndex(id, "/")+1:], nil
+}
+
+// isArnOfService reports whether s is the ARN of a resource of service in
+// partition.
+func isArnOfService(s, partition, service string) bool {
+	a, err := arn.Parse(s)
+	return err == nil && a.Partition == partition && a.Service == service
+}
+
+// isArn reports whether s is an ARN, rather than another identifier of a
+// resource such as its name or unique ID.
+func isArn(s string) bool {
+	_, err := arn.Parse(s)
+	return err == nil
+}
+
+// regionalArn returns the ARN of a resource of service in the region and
+// account of c, e.g. cluster/name for an ECS cluster.
+func (c *AWSClient) regionalArn(service, resource string) string {
+	return arn.ARN{
+		Partition: c.partition,
+		Service:   service,
+		Region:    c.region,
+		AccountID: c.accountid,
+		Resource:  resource,
+	}.String()
+}
+
+// globalArn returns the ARN of a resource of a global service, such as IAM
+// or CloudFront, in the account of c.
+func (c *AWSClient) globalArn(service, resource string) string {
+	return arn.ARN{
+		Partition: c.partition,
+		Service:   service,
+		AccountID: c.accountid,
+		Resource:  resource,
+	}.String()
+}
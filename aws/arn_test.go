@@ -0,0 +1,101 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestParseArnResource(t *testing.T) {
+	cases := []struct {
+		Arn          string
+		ResourceType string
+		ID           string
+		ErrCount     int
+	}{
+		{"arn:aws:iam::123456789012:role/EcsService", "role", "EcsService", 0},
+		{"arn:aws:iam::123456789012:role/path/to/EcsService", "role", "path/to/EcsService", 0},
+		{"arn:aws:ecs:us-west-2:123456789012:task-definition/family:3", "task-definition", "family:3", 0},
+		{"arn:aws:lambda:us-west-2:123456789012:function:example:live", "function", "example:live", 0},
+		{"arn:aws:sns:us-west-2:123456789012:topic", "", "topic", 0},
+		{"arn:aws-us-gov:s3:::bucket", "", "bucket", 0},
+		{"example", "", "", 1},
+		{"arn:aws:sns:us-west-2", "", "", 1},
+	}
+
+	for _, tc := range cases {
+		_, resourceType, id, err := parseArnResource(tc.Arn)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Arn, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("%q: expected an error", tc.Arn)
+		}
+		if resourceType != tc.ResourceType || id != tc.ID {
+			t.Fatalf("%q: expected (%q, %q), got (%q, %q)", tc.Arn, tc.ResourceType, tc.ID, resourceType, id)
+		}
+	}
+}
+
+func TestArnResourceName(t *testing.T) {
+	cases := []struct {
+		Arn      string
+		Name     string
+		ErrCount int
+	}{
+		{"arn:aws:iam::123456789012:role/EcsService", "EcsService", 0},
+		{"arn:aws:iam::123456789012:instance-profile/path/to/profile", "profile", 0},
+		{"arn:aws:ecs:us-west-2:123456789012:cluster/example", "example", 0},
+		{"arn:aws:ecs:us-west-2:123456789012:task-definition/family:3", "family:3", 0},
+		{"arn:aws:sns:us-west-2:123456789012:topic", "topic", 0},
+		{"arn:aws:ecs:us-west-2:123456789012:", "", 1},
+		{"EcsService", "", 1},
+	}
+
+	for _, tc := range cases {
+		name, err := arnResourceName(tc.Arn)
+		if tc.ErrCount == 0 && err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Arn, err)
+		}
+		if tc.ErrCount > 0 && err == nil {
+			t.Fatalf("%q: expected an error", tc.Arn)
+		}
+		if name != tc.Name {
+			t.Fatalf("%q: expected %q, got %q", tc.Arn, tc.Name, name)
+		}
+	}
+}
+
+func TestIsArnOfService(t *testing.T) {
+	cases := []struct {
+		Arn       string
+		Partition string
+		Service   string
+		Expected  bool
+	}{
+		{"arn:aws:ecs:us-west-2:123456789012:cluster/example", "aws", "ecs", true},
+		{"arn:aws-cn:ecs:cn-north-1:123456789012:cluster/example", "aws-cn", "ecs", true},
+		{"arn:aws-cn:ecs:cn-north-1:123456789012:cluster/example", "aws", "ecs", false},
+		{"arn:aws:iam::123456789012:role/example", "aws", "ecs", false},
+		{"example", "aws", "ecs", false},
+	}
+
+	for _, tc := range cases {
+		if actual := isArnOfService(tc.Arn, tc.Partition, tc.Service); actual != tc.Expected {
+			t.Fatalf("%q: expected %t for %s in %s, got %t", tc.Arn, tc.Expected, tc.Service, tc.Partition, actual)
+		}
+	}
+}
+
+func TestAWSClientArn(t *testing.T) {
+	client := &AWSClient{
+		accountid: "123456789012",
+		partition: "aws-cn",
+		region:    "cn-north-1",
+	}
+
+	if actual, expected := client.regionalArn("ecs", "cluster/example"), "arn:aws-cn:ecs:cn-north-1:123456789012:cluster/example"; actual != expected {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+	if actual, expected := client.globalArn("iam", "role/example"), "arn:aws-cn:iam::123456789012:role/example"; actual != expected {
+		t.Fatalf("expected %q, got %q", expected, actual)
+	}
+}
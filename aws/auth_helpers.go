@@ -3,8 +3,11 @@ package aws
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -15,10 +18,10 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/defaults"
 	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
-	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -155,6 +158,17 @@ func parseAccountIDAndPartitionFromARN(inputARN string) (string, string, error)
 // environment in the case that they're not explicitly specified
 // in the Terraform configuration.
 func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
+	// Credentials obtained with a web identity token configured in the
+	// provider take the place of the default chain.
+	if c.AssumeRoleWithWebIdentity != nil {
+		creds, err := getWebIdentityCredentials(c, c.AssumeRoleWithWebIdentity)
+		if err != nil {
+			return nil, err
+		}
+
+		return getAssumeRoleChainCredentials(c, creds)
+	}
+
 	// build a chain provider, lazy-evaluated by aws-sdk
 	providers := []awsCredentials.Provider{
 		&awsCredentials.StaticProvider{Value: awsCredentials.Value{
@@ -163,14 +177,35 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 			SessionToken:    c.Token,
 		}},
 		&awsCredentials.EnvProvider{},
-		&awsCredentials.SharedCredentialsProvider{
-			Filename: c.CredsFilename,
-			Profile:  c.Profile,
-		},
 	}
 
+	// Add a web identity provider if the environment holds a token file and
+	// role, as set for example by EKS or CI systems issuing OIDC tokens
+	if tokenFile := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"); tokenFile != "" {
+		if roleARN := os.Getenv("AWS_ROLE_ARN"); roleARN != "" {
+			provider, err := newWebIdentityRoleProvider(c, &AssumeRoleWithWebIdentity{
+				RoleARN:              roleARN,
+				SessionName:          os.Getenv("AWS_ROLE_SESSION_NAME"),
+				WebIdentityTokenFile: tokenFile,
+			})
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, provider)
+			log.Print("[INFO] Web identity token file detected, WebIdentityRoleProvider added to auth chain")
+		}
+	}
+
+	providers = append(providers, &sharedConfigProvider{
+		config:  c,
+		profile: c.Profile,
+	})
+
 	// Build isolated HTTP client to avoid issues with globally-shared settings
-	client := cleanhttp.DefaultClient()
+	client, err := c.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
 
 	// Keep the default timeout (100ms) low as we don't want to wait in non-EC2 environments
 	client.Timeout = 100 * time.Millisecond
@@ -222,17 +257,20 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 		}
 	}
 
+	// Errors from each provider are kept so that, for example, a broken shared
+	// config profile is reported rather than a bare "no valid providers".
+	creds := awsCredentials.NewCredentials(&awsCredentials.ChainProvider{
+		Providers:     providers,
+		VerboseErrors: true,
+	})
+
 	// This is the "normal" flow (i.e. not assuming a role)
-	if c.AssumeRoleARN == "" {
-		return awsCredentials.NewChainCredentials(providers), nil
+	if len(c.AssumeRoles) == 0 {
+		return creds, nil
 	}
 
 	// Otherwise we need to construct and STS client with the main credentials, and verify
-	// that we can assume the defined role.
-	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q)",
-		c.AssumeRoleARN, c.AssumeRoleSessionName, c.AssumeRoleExternalID, c.AssumeRolePolicy)
-
-	creds := awsCredentials.NewChainCredentials(providers)
+	// that we can assume the defined roles.
 	cp, err := creds.Get()
 	if err != nil {
 		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "NoCredentialProviders" {
@@ -246,30 +284,79 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 
 	log.Printf("[INFO] AWS Auth provider used: %q", cp.ProviderName)
 
+	return getAssumeRoleChainCredentials(c, creds)
+}
+
+// getAssumeRoleChainCredentials returns the credentials of the last role in
+// the provider assume_role chain, or creds if there is none. Each role in the
+// chain is assumed with the credentials of the one before it.
+func getAssumeRoleChainCredentials(c *Config, creds *awsCredentials.Credentials) (*awsCredentials.Credentials, error) {
+	var err error
+	for _, role := range c.AssumeRoles {
+		creds, err = getAssumeRoleCredentials(c, creds, role)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return creds, nil
+}
+
+// newCredentialsStsClient returns an STS client for obtaining role
+// credentials, calling the provider STS endpoint with creds.
+func newCredentialsStsClient(c *Config, creds *awsCredentials.Credentials) (*sts.STS, error) {
+	client, err := c.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
 	awsConfig := &aws.Config{
 		Credentials:      creds,
 		Region:           aws.String(c.Region),
 		MaxRetries:       aws.Int(c.MaxRetries),
-		HTTPClient:       cleanhttp.DefaultClient(),
+		HTTPClient:       client,
 		S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 	}
+	if c.Endpoints["sts"] != "" {
+		awsConfig.Endpoint = aws.String(c.Endpoints["sts"])
+	}
+
+	return sts.New(session.New(awsConfig)), nil
+}
+
+// getAssumeRoleCredentials returns credentials for the given role, assumed
+// using creds, and verifies that the role can be assumed.
+func getAssumeRoleCredentials(c *Config, creds *awsCredentials.Credentials, role *AssumeRole) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRole %s (SessionName: %q, ExternalId: %q, Policy: %q, PolicyARNs: %q, Duration: %s, SerialNumber: %q)",
+		role.RoleARN, role.SessionName, role.ExternalID, role.Policy, role.PolicyARNs, role.Duration, role.SerialNumber)
+
+	stsclient, err := newCredentialsStsClient(c, creds)
+	if err != nil {
+		return nil, err
+	}
 
-	stsclient := sts.New(session.New(awsConfig))
 	assumeRoleProvider := &stscreds.AssumeRoleProvider{
-		Client:  stsclient,
-		RoleARN: c.AssumeRoleARN,
+		Client:   &assumeRoleClient{conn: stsclient, role: role},
+		RoleARN:  role.RoleARN,
+		Duration: role.Duration,
+	}
+	if role.SessionName != "" {
+		assumeRoleProvider.RoleSessionName = role.SessionName
 	}
-	if c.AssumeRoleSessionName != "" {
-		assumeRoleProvider.RoleSessionName = c.AssumeRoleSessionName
+	if role.ExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(role.ExternalID)
 	}
-	if c.AssumeRoleExternalID != "" {
-		assumeRoleProvider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	if role.Policy != "" {
+		assumeRoleProvider.Policy = aws.String(role.Policy)
 	}
-	if c.AssumeRolePolicy != "" {
-		assumeRoleProvider.Policy = aws.String(c.AssumeRolePolicy)
+	if role.SerialNumber != "" {
+		assumeRoleProvider.SerialNumber = aws.String(role.SerialNumber)
+		if role.TokenCode != "" {
+			assumeRoleProvider.TokenCode = aws.String(role.TokenCode)
+		}
 	}
 
-	providers = []awsCredentials.Provider{assumeRoleProvider}
+	providers := []awsCredentials.Provider{assumeRoleProvider}
 
 	assumeRoleCreds := awsCredentials.NewChainCredentials(providers)
 	_, err = assumeRoleCreds.Get()
@@ -280,7 +367,7 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 				"    * The credentials used in order to assume the role are invalid\n"+
 				"    * The credentials do not have appropriate permission to assume the role\n"+
 				"    * The role ARN is not valid",
-				c.AssumeRoleARN)
+				role.RoleARN)
 		}
 
 		return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -289,6 +376,142 @@ func GetCredentials(c *Config) (*awsCredentials.Credentials, error) {
 	return assumeRoleCreds, nil
 }
 
+// getWebIdentityCredentials returns credentials for the given role, obtained
+// with the web identity token in its token file, and verifies that the role
+// can be assumed.
+func getWebIdentityCredentials(c *Config, role *AssumeRoleWithWebIdentity) (*awsCredentials.Credentials, error) {
+	log.Printf("[INFO] Attempting to AssumeRoleWithWebIdentity %s (SessionName: %q, WebIdentityTokenFile: %q, Duration: %s)",
+		role.RoleARN, role.SessionName, role.WebIdentityTokenFile, role.Duration)
+
+	provider, err := newWebIdentityRoleProvider(c, role)
+	if err != nil {
+		return nil, err
+	}
+
+	creds := awsCredentials.NewCredentials(provider)
+	if _, err := creds.Get(); err != nil {
+		return nil, fmt.Errorf("The role %q cannot be assumed with the web identity token in %q: %s",
+			role.RoleARN, role.WebIdentityTokenFile, err)
+	}
+
+	return creds, nil
+}
+
+// webIdentityProviderName is the name of the webIdentityRoleProvider.
+const webIdentityProviderName = "WebIdentityCredentials"
+
+// credentialsExpiryWindow is how long before they expire the temporary
+// credentials retrieved by the provider's own credential providers are
+// refreshed.
+const credentialsExpiryWindow = 1 * time.Minute
+
+// webIdentityRoleProvider retrieves the credentials of a role in exchange for
+// the web identity token held in a file, using sts:AssumeRoleWithWebIdentity.
+// The file is read on every retrieval, so that tokens rotated on disk are
+// picked up when the credentials are refreshed.
+type webIdentityRoleProvider struct {
+	awsCredentials.Expiry
+
+	client *sts.STS
+	role   *AssumeRoleWithWebIdentity
+}
+
+func newWebIdentityRoleProvider(c *Config, role *AssumeRoleWithWebIdentity) (*webIdentityRoleProvider, error) {
+	// The token is the proof of identity, so requests are not signed.
+	client, err := newCredentialsStsClient(c, awsCredentials.AnonymousCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return &webIdentityRoleProvider{
+		client: client,
+		role:   role,
+	}, nil
+}
+
+func (p *webIdentityRoleProvider) Retrieve() (awsCredentials.Value, error) {
+	token, err := ioutil.ReadFile(p.role.WebIdentityTokenFile)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityProviderName},
+			awserr.New("WebIdentityErr", fmt.Sprintf("unable to read web identity token file %s", p.role.WebIdentityTokenFile), err)
+	}
+
+	sessionName := p.role.SessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	input := &sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.role.RoleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(strings.TrimSpace(string(token))),
+	}
+	if p.role.Duration != 0 {
+		input.DurationSeconds = aws.Int64(int64(p.role.Duration / time.Second))
+	}
+
+	log.Printf("[DEBUG] Retrieving credentials for role %s with web identity token file %s", p.role.RoleARN, p.role.WebIdentityTokenFile)
+	output, err := p.client.AssumeRoleWithWebIdentity(input)
+	if err != nil {
+		return awsCredentials.Value{ProviderName: webIdentityProviderName}, err
+	}
+
+	p.SetExpiration(aws.TimeValue(output.Credentials.Expiration), credentialsExpiryWindow)
+
+	return awsCredentials.Value{
+		AccessKeyID:     aws.StringValue(output.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(output.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(output.Credentials.SessionToken),
+		ProviderName:    webIdentityProviderName,
+	}, nil
+}
+
+// assumeRoleClient calls sts:AssumeRole on behalf of stscreds.AssumeRoleProvider,
+// adding the session policy ARNs, session tags and transitive tag keys of the
+// role, which the provider does not pass itself.
+type assumeRoleClient struct {
+	conn *sts.STS
+	role *AssumeRole
+}
+
+func (c *assumeRoleClient) AssumeRole(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+	req, out := c.conn.AssumeRoleRequest(input)
+	req.Handlers.Build.PushBack(c.buildAssumeRoleParams)
+	return out, req.Send()
+}
+
+// buildAssumeRoleParams adds the role's policy ARNs, session tags and
+// transitive tag keys to the query parameters of an AssumeRole request.
+func (c *assumeRoleClient) buildAssumeRoleParams(r *request.Request) {
+	if len(c.role.PolicyARNs) == 0 && len(c.role.Tags) == 0 && len(c.role.TransitiveTagKeys) == 0 {
+		return
+	}
+
+	b, err := ioutil.ReadAll(r.GetBody())
+	if err != nil {
+		r.Error = awserr.New("SerializationError", "failed reading AssumeRole request body", err)
+		return
+	}
+	params, err := url.ParseQuery(string(b))
+	if err != nil {
+		r.Error = awserr.New("SerializationError", "failed parsing AssumeRole request body", err)
+		return
+	}
+
+	for i, v := range c.role.PolicyARNs {
+		params.Set(fmt.Sprintf("PolicyArns.member.%d.arn", i+1), v)
+	}
+	for i, k := range keyValueTags(c.role.Tags).Keys() {
+		params.Set(fmt.Sprintf("Tags.member.%d.Key", i+1), k)
+		params.Set(fmt.Sprintf("Tags.member.%d.Value", i+1), c.role.Tags[k])
+	}
+	for i, v := range c.role.TransitiveTagKeys {
+		params.Set(fmt.Sprintf("TransitiveTagKeys.member.%d", i+1), v)
+	}
+
+	r.SetBufferBody([]byte(params.Encode()))
+}
+
 func setOptionalEndpoint(cfg *aws.Config) string {
 	endpoint := os.Getenv("AWS_METADATA_URL")
 	if endpoint != "" {
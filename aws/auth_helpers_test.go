@@ -6,10 +6,14 @@ import (
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -747,6 +751,292 @@ func TestAWSGetCredentials_shouldBeENV(t *testing.T) {
 	}
 }
 
+func TestAWSGetCredentials_shouldAssumeRoleChain(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	defer invalidAwsEnv(t)()
+
+	requests, ts := stsAssumeRoleMock(t, nil)
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey: "accessKey",
+		SecretKey: "secretKey",
+		Region:    "us-east-1",
+		Endpoints: map[string]string{"sts": ts.URL},
+		AssumeRoles: []*AssumeRole{
+			{
+				RoleARN:           "arn:aws:iam::111111111111:role/hub",
+				SessionName:       "ci",
+				Duration:          time.Hour,
+				SerialNumber:      "arn:aws:iam::000000000000:mfa/ci",
+				TokenCode:         "123456",
+				PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+				Tags:              map[string]string{"Team": "platform", "Environment": "ci"},
+				TransitiveTagKeys: []string{"Team"},
+			},
+			{
+				RoleARN:    "arn:aws:iam::222222222222:role/workload",
+				ExternalID: "workload-external-id",
+			},
+		},
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if expected := "AKIDworkload"; v.AccessKeyID != expected {
+		t.Fatalf("AccessKeyID mismatch, expected: (%s), got (%s)", expected, v.AccessKeyID)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 AssumeRole requests, got %d", len(*requests))
+	}
+
+	hub := (*requests)[0]
+	expectedHubParams := map[string]string{
+		"RoleArn":                    "arn:aws:iam::111111111111:role/hub",
+		"RoleSessionName":            "ci",
+		"DurationSeconds":            "3600",
+		"SerialNumber":               "arn:aws:iam::000000000000:mfa/ci",
+		"TokenCode":                  "123456",
+		"PolicyArns.member.1.arn":    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+		"Tags.member.1.Key":          "Environment",
+		"Tags.member.1.Value":        "ci",
+		"Tags.member.2.Key":          "Team",
+		"Tags.member.2.Value":        "platform",
+		"TransitiveTagKeys.member.1": "Team",
+	}
+	for k, expected := range expectedHubParams {
+		if actual := hub.Form.Get(k); actual != expected {
+			t.Fatalf("Hub AssumeRole parameter %s mismatch, expected: (%s), got (%s)", k, expected, actual)
+		}
+	}
+	if !strings.Contains(hub.Authorization, "Credential=accessKey/") {
+		t.Fatalf("Expected hub role to be assumed with the provider credentials, got %q", hub.Authorization)
+	}
+
+	workload := (*requests)[1]
+	if actual, expected := workload.Form.Get("RoleArn"), "arn:aws:iam::222222222222:role/workload"; actual != expected {
+		t.Fatalf("Workload RoleArn mismatch, expected: (%s), got (%s)", expected, actual)
+	}
+	if actual, expected := workload.Form.Get("ExternalId"), "workload-external-id"; actual != expected {
+		t.Fatalf("Workload ExternalId mismatch, expected: (%s), got (%s)", expected, actual)
+	}
+	for _, k := range []string{"SerialNumber", "PolicyArns.member.1.arn", "Tags.member.1.Key", "TransitiveTagKeys.member.1"} {
+		if _, ok := workload.Form[k]; ok {
+			t.Fatalf("Unexpected workload AssumeRole parameter %s", k)
+		}
+	}
+	if !strings.Contains(workload.Authorization, "Credential=AKIDhub/") {
+		t.Fatalf("Expected workload role to be assumed with the hub role credentials, got %q", workload.Authorization)
+	}
+}
+
+func TestAWSGetCredentials_shouldErrorAssumingRole(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	defer invalidAwsEnv(t)()
+
+	_, ts := stsAssumeRoleMock(t, map[string]bool{"arn:aws:iam::222222222222:role/workload": true})
+	defer ts.Close()
+
+	cfg := Config{
+		AccessKey: "accessKey",
+		SecretKey: "secretKey",
+		Region:    "us-east-1",
+		Endpoints: map[string]string{"sts": ts.URL},
+		AssumeRoles: []*AssumeRole{
+			{RoleARN: "arn:aws:iam::111111111111:role/hub"},
+			{RoleARN: "arn:aws:iam::222222222222:role/workload"},
+		},
+	}
+
+	_, err := GetCredentials(&cfg)
+	if err == nil {
+		t.Fatal("Expected an error assuming the workload role")
+	}
+	if !strings.Contains(err.Error(), `"arn:aws:iam::222222222222:role/workload" cannot be assumed`) {
+		t.Fatalf("Expected error to name the workload role, got: %s", err)
+	}
+}
+
+func TestAWSGetCredentials_shouldAssumeRoleWithWebIdentity(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	defer invalidAwsEnv(t)()
+
+	requests, ts := stsAssumeRoleMock(t, nil)
+	defer ts.Close()
+
+	tokenFile, removeTokenFile := writeWebIdentityTokenFile(t, "first-token\n")
+	defer removeTokenFile()
+
+	cfg := Config{
+		Region:    "us-east-1",
+		Endpoints: map[string]string{"sts": ts.URL},
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/ci",
+			SessionName:          "ci",
+			WebIdentityTokenFile: tokenFile,
+			Duration:             time.Hour,
+		},
+		AssumeRoles: []*AssumeRole{
+			{RoleARN: "arn:aws:iam::222222222222:role/workload"},
+		},
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if expected := "AKIDworkload"; v.AccessKeyID != expected {
+		t.Fatalf("AccessKeyID mismatch, expected: (%s), got (%s)", expected, v.AccessKeyID)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 STS requests, got %d", len(*requests))
+	}
+
+	webIdentity := (*requests)[0]
+	expectedParams := map[string]string{
+		"Action":           "AssumeRoleWithWebIdentity",
+		"RoleArn":          "arn:aws:iam::111111111111:role/ci",
+		"RoleSessionName":  "ci",
+		"DurationSeconds":  "3600",
+		"WebIdentityToken": "first-token",
+	}
+	for k, expected := range expectedParams {
+		if actual := webIdentity.Form.Get(k); actual != expected {
+			t.Fatalf("AssumeRoleWithWebIdentity parameter %s mismatch, expected: (%s), got (%s)", k, expected, actual)
+		}
+	}
+	if webIdentity.Authorization != "" {
+		t.Fatalf("Expected AssumeRoleWithWebIdentity request to be unsigned, got %q", webIdentity.Authorization)
+	}
+
+	if !strings.Contains((*requests)[1].Authorization, "Credential=AKIDci/") {
+		t.Fatalf("Expected workload role to be assumed with the web identity credentials, got %q", (*requests)[1].Authorization)
+	}
+}
+
+func TestAWSGetCredentials_shouldAssumeRoleWithWebIdentityFromEnv(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	defer invalidAwsEnv(t)()
+
+	_, ts := stsAssumeRoleMock(t, nil)
+	defer ts.Close()
+
+	tokenFile, removeTokenFile := writeWebIdentityTokenFile(t, "env-token")
+	defer removeTokenFile()
+
+	for k, v := range map[string]string{
+		"AWS_WEB_IDENTITY_TOKEN_FILE": tokenFile,
+		"AWS_ROLE_ARN":                "arn:aws:iam::111111111111:role/env",
+	} {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("Error setting env var %s: %s", k, err)
+		}
+		defer os.Unsetenv(k)
+	}
+
+	cfg := Config{
+		Region:        "us-east-1",
+		Endpoints:     map[string]string{"sts": ts.URL},
+		CredsFilename: "/dev/null/nonexistent",
+	}
+
+	creds, err := GetCredentials(&cfg)
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	v, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if expected := "AKIDenv"; v.AccessKeyID != expected {
+		t.Fatalf("AccessKeyID mismatch, expected: (%s), got (%s)", expected, v.AccessKeyID)
+	}
+	if v.ProviderName != webIdentityProviderName {
+		t.Fatalf("ProviderName mismatch, expected: (%s), got (%s)", webIdentityProviderName, v.ProviderName)
+	}
+}
+
+func TestAWSGetCredentials_shouldErrorWithMissingWebIdentityTokenFile(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	cfg := Config{
+		Region: "us-east-1",
+		AssumeRoleWithWebIdentity: &AssumeRoleWithWebIdentity{
+			RoleARN:              "arn:aws:iam::111111111111:role/ci",
+			WebIdentityTokenFile: "/nonexistent/token",
+		},
+	}
+
+	_, err := GetCredentials(&cfg)
+	if err == nil {
+		t.Fatal("Expected an error with a missing web identity token file")
+	}
+	if !strings.Contains(err.Error(), "/nonexistent/token") {
+		t.Fatalf("Expected error to name the token file, got: %s", err)
+	}
+}
+
+func TestWebIdentityRoleProvider_refresh(t *testing.T) {
+	requests, ts := stsAssumeRoleMock(t, nil)
+	defer ts.Close()
+
+	tokenFile, removeTokenFile := writeWebIdentityTokenFile(t, "first-token")
+	defer removeTokenFile()
+
+	provider, err := newWebIdentityRoleProvider(&Config{Region: "us-east-1", Endpoints: map[string]string{"sts": ts.URL}}, &AssumeRoleWithWebIdentity{
+		RoleARN:              "arn:aws:iam::111111111111:role/ci",
+		WebIdentityTokenFile: tokenFile,
+	})
+	if err != nil {
+		t.Fatalf("Error creating web identity provider: %s", err)
+	}
+	creds := awsCredentials.NewCredentials(provider)
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+	if provider.IsExpired() {
+		t.Fatal("Expected retrieved credentials not to be expired")
+	}
+
+	// Rotate the token and let the credentials expire.
+	if err := ioutil.WriteFile(tokenFile, []byte("second-token"), 0600); err != nil {
+		t.Fatalf("Error writing token file: %s", err)
+	}
+	creds.Expire()
+
+	if _, err := creds.Get(); err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 STS requests, got %d", len(*requests))
+	}
+	if actual := (*requests)[1].Form.Get("WebIdentityToken"); actual != "second-token" {
+		t.Fatalf("Expected refreshed credentials to use the rotated token, got %q", actual)
+	}
+}
+
 // unsetEnv unsets environment variables for testing a "clean slate" with no
 // credentials in the environment
 func unsetEnv(t *testing.T) func() {
@@ -828,6 +1118,66 @@ func setEnv(s string, t *testing.T) func() {
 	}
 }
 
+// stsAssumeRoleRequest is an AssumeRole request received by stsAssumeRoleMock.
+type stsAssumeRoleRequest struct {
+	Form          url.Values
+	Authorization string
+}
+
+// stsAssumeRoleMock establishes a httptest server to mock out the STS
+// AssumeRole and AssumeRoleWithWebIdentity APIs. Each role is granted an access key named after the last
+// element of its ARN, except those in denied, for which access is denied.
+// The requests received are recorded in order.
+func stsAssumeRoleMock(t *testing.T, denied map[string]bool) (*[]stsAssumeRoleRequest, *httptest.Server) {
+	var requests []stsAssumeRoleRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("Error parsing STS request: %s", err)
+			w.WriteHeader(400)
+			return
+		}
+		log.Printf("[DEBUG] Mocked STS API received request: %s", r.Form.Encode())
+
+		requests = append(requests, stsAssumeRoleRequest{
+			Form:          r.Form,
+			Authorization: r.Header.Get("Authorization"),
+		})
+
+		roleArn := r.Form.Get("RoleArn")
+		w.Header().Set("Content-Type", "text/xml")
+		if denied[roleArn] {
+			w.WriteHeader(403)
+			fmt.Fprintf(w, stsResponse_AssumeRole_unauthorized, roleArn)
+			return
+		}
+
+		name := roleArn[strings.LastIndex(roleArn, "/")+1:]
+		if r.Form.Get("Action") == "AssumeRoleWithWebIdentity" {
+			fmt.Fprintf(w, stsResponse_AssumeRoleWithWebIdentity_valid, name, name, name, roleArn)
+			return
+		}
+		fmt.Fprintf(w, stsResponse_AssumeRole_valid, name, name, name, roleArn)
+	}))
+
+	return &requests, ts
+}
+
+// writeWebIdentityTokenFile writes a web identity token to a temporary file,
+// returning its path and a function removing it.
+func writeWebIdentityTokenFile(t *testing.T, token string) (string, func()) {
+	f, err := ioutil.TempFile("", "tf-aws-web-identity-token")
+	if err != nil {
+		t.Fatalf("Error creating token file: %s", err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(token); err != nil {
+		t.Fatalf("Error writing token file: %s", err)
+	}
+
+	return f.Name(), func() { os.Remove(f.Name()) }
+}
+
 // awsMetadataApiMock establishes a httptest server to mock out the internal AWS Metadata
 // service. IAM Credentials are retrieved by the EC2RoleProvider, which makes
 // API calls to this internal URL. By replacing the server with a test server,
@@ -999,3 +1349,49 @@ const iamResponse_ListRoles_unauthorized = `<ErrorResponse xmlns="https://iam.am
   </Error>
   <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
 </ErrorResponse>`
+
+const stsResponse_AssumeRole_valid = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>AKID%s</AccessKeyId>
+      <SecretAccessKey>secret-%s</SecretAccessKey>
+      <SessionToken>token-%s</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>%s</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`
+
+const stsResponse_AssumeRoleWithWebIdentity_valid = `<AssumeRoleWithWebIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleWithWebIdentityResult>
+    <Credentials>
+      <AccessKeyId>AKID%s</AccessKeyId>
+      <SecretAccessKey>secret-%s</SecretAccessKey>
+      <SessionToken>token-%s</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>%s</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <SubjectFromWebIdentityToken>ci</SubjectFromWebIdentityToken>
+  </AssumeRoleWithWebIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleWithWebIdentityResponse>`
+
+const stsResponse_AssumeRole_unauthorized = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>Not authorized to perform sts:AssumeRole on %s</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
@@ -0,0 +1,420 @@
+package aws
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ec2rolecreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/defaults"
+	"github.com/aws/aws-sdk-go/aws/ec2metadata"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/go-ini/ini"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// sharedConfigProviderName is the name of the sharedConfigProvider.
+const sharedConfigProviderName = "SharedConfigProvider"
+
+// sharedConfigProvider retrieves the credentials of a profile defined in the
+// shared credentials and config files. The files are only read on the first
+// retrieval, so that a missing or invalid profile does not get in the way of
+// credentials found earlier in the chain.
+type sharedConfigProvider struct {
+	config  *Config
+	profile string
+
+	provider awsCredentials.Provider
+}
+
+func (p *sharedConfigProvider) profileName() string {
+	if p.profile != "" {
+		return p.profile
+	}
+	if v := os.Getenv("AWS_PROFILE"); v != "" {
+		return v
+	}
+	return "default"
+}
+
+func (p *sharedConfigProvider) Retrieve() (awsCredentials.Value, error) {
+	name := p.profileName()
+
+	if p.provider == nil {
+		files, err := loadSharedConfigFiles(p.config)
+		if err != nil {
+			return awsCredentials.Value{ProviderName: sharedConfigProviderName}, err
+		}
+
+		provider, err := files.provider(p.config, name, map[string]bool{})
+		if err != nil {
+			return awsCredentials.Value{ProviderName: sharedConfigProviderName},
+				awserr.New("SharedConfigErr", fmt.Sprintf("error loading credentials from shared config profile %q", name), err)
+		}
+		p.provider = provider
+	}
+
+	v, err := p.provider.Retrieve()
+	if err != nil {
+		return awsCredentials.Value{ProviderName: sharedConfigProviderName},
+			awserr.New("SharedConfigErr", fmt.Sprintf("error loading credentials from shared config profile %q", name), err)
+	}
+
+	return v, nil
+}
+
+func (p *sharedConfigProvider) IsExpired() bool {
+	if p.provider == nil {
+		return true
+	}
+
+	return p.provider.IsExpired()
+}
+
+// sharedConfigProfile holds the settings of a profile in the shared
+// credentials and config files.
+type sharedConfigProfile struct {
+	Name string
+
+	Creds awsCredentials.Value
+
+	RoleARN          string
+	SourceProfile    string
+	CredentialSource string
+	ExternalID       string
+	MFASerial        string
+	RoleSessionName  string
+	Duration         time.Duration
+
+	CredentialProcess string
+}
+
+// sharedConfigFiles holds the parsed shared credentials and config files.
+// Either may be nil if the file does not exist.
+type sharedConfigFiles struct {
+	CredentialsFilename string
+	ConfigFilename      string
+
+	credentials *ini.File
+	config      *ini.File
+}
+
+// loadSharedConfigFiles reads the shared credentials and config files. Their
+// locations are taken from the provider configuration, then the
+// AWS_SHARED_CREDENTIALS_FILE and AWS_CONFIG_FILE environment variables, and
+// default to ~/.aws/credentials and ~/.aws/config.
+func loadSharedConfigFiles(c *Config) (*sharedConfigFiles, error) {
+	files := &sharedConfigFiles{
+		CredentialsFilename: sharedConfigFilename(c.CredsFilename, "AWS_SHARED_CREDENTIALS_FILE", "credentials"),
+		ConfigFilename:      sharedConfigFilename(c.SharedConfigFile, "AWS_CONFIG_FILE", "config"),
+	}
+
+	var err error
+	if files.credentials, err = loadSharedConfigFile(files.CredentialsFilename); err != nil {
+		return nil, err
+	}
+	if files.config, err = loadSharedConfigFile(files.ConfigFilename); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func sharedConfigFilename(filename, envVar, defaultName string) string {
+	if filename != "" {
+		return filename
+	}
+	if v := os.Getenv(envVar); v != "" {
+		return v
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		log.Printf("[WARN] Unable to find home directory for the shared %s file: %s", defaultName, err)
+		return ""
+	}
+
+	return filepath.Join(home, ".aws", defaultName)
+}
+
+func loadSharedConfigFile(filename string) (*ini.File, error) {
+	if filename == "" {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("[DEBUG] Shared config file %s not found", filename)
+			return nil, nil
+		}
+		return nil, awserr.New("SharedConfigLoadError", fmt.Sprintf("error reading shared config file %s", filename), err)
+	}
+
+	f, err := ini.Load(b)
+	if err != nil {
+		return nil, awserr.New("SharedConfigLoadError", fmt.Sprintf("error parsing shared config file %s", filename), err)
+	}
+
+	return f, nil
+}
+
+// profile returns the named profile. Settings in the shared credentials file
+// take precedence over those in the shared config file, where profiles other
+// than the default one are named "profile NAME".
+func (f *sharedConfigFiles) profile(name string) (*sharedConfigProfile, error) {
+	var sections []*ini.Section
+	if f.config != nil {
+		for _, sectionName := range []string{"profile " + name, name} {
+			if section, err := f.config.GetSection(sectionName); err == nil {
+				sections = append(sections, section)
+				break
+			}
+		}
+	}
+	if f.credentials != nil {
+		if section, err := f.credentials.GetSection(name); err == nil {
+			sections = append(sections, section)
+		}
+	}
+
+	if len(sections) == 0 {
+		return nil, fmt.Errorf("profile %q not found in shared credentials file %q or shared config file %q",
+			name, f.CredentialsFilename, f.ConfigFilename)
+	}
+
+	settings := make(map[string]string)
+	for _, section := range sections {
+		for k, v := range section.KeysHash() {
+			settings[k] = v
+		}
+	}
+
+	profile := &sharedConfigProfile{
+		Name: name,
+		Creds: awsCredentials.Value{
+			AccessKeyID:     settings["aws_access_key_id"],
+			SecretAccessKey: settings["aws_secret_access_key"],
+			SessionToken:    settings["aws_session_token"],
+			ProviderName:    sharedConfigProviderName,
+		},
+		RoleARN:           settings["role_arn"],
+		SourceProfile:     settings["source_profile"],
+		CredentialSource:  settings["credential_source"],
+		ExternalID:        settings["external_id"],
+		MFASerial:         settings["mfa_serial"],
+		RoleSessionName:   settings["role_session_name"],
+		CredentialProcess: settings["credential_process"],
+	}
+
+	if v := settings["duration_seconds"]; v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: invalid duration_seconds %q: %s", name, v, err)
+		}
+		profile.Duration = time.Duration(seconds) * time.Second
+	}
+
+	return profile, nil
+}
+
+// provider returns a credentials provider for the named profile, following
+// its source_profile chain. visited holds the profiles already seen in the
+// chain, to detect loops.
+func (f *sharedConfigFiles) provider(c *Config, name string, visited map[string]bool) (awsCredentials.Provider, error) {
+	if visited[name] {
+		return nil, fmt.Errorf("profile %q: source_profile chain loops back to this profile", name)
+	}
+	visited[name] = true
+
+	profile, err := f.profile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	hasStaticCreds := profile.Creds.AccessKeyID != "" && profile.Creds.SecretAccessKey != ""
+
+	var provider awsCredentials.Provider
+	switch {
+	case profile.RoleARN != "" && profile.SourceProfile != "":
+		if profile.SourceProfile == name {
+			if !hasStaticCreds {
+				return nil, fmt.Errorf("profile %q: source_profile refers to the profile itself, which has no static credentials", name)
+			}
+			provider = &awsCredentials.StaticProvider{Value: profile.Creds}
+			break
+		}
+
+		provider, err = f.provider(c, profile.SourceProfile, visited)
+		if err != nil {
+			return nil, fmt.Errorf("profile %q: error loading source_profile: %s", name, err)
+		}
+	case profile.RoleARN != "" && profile.CredentialSource != "":
+		provider, err = credentialSourceProvider(c, profile)
+		if err != nil {
+			return nil, err
+		}
+	case hasStaticCreds:
+		return &awsCredentials.StaticProvider{Value: profile.Creds}, nil
+	case profile.CredentialProcess != "":
+		return &processCredentialsProvider{command: profile.CredentialProcess}, nil
+	case profile.RoleARN != "":
+		return nil, fmt.Errorf("profile %q: role_arn requires either source_profile or credential_source", name)
+	default:
+		return nil, fmt.Errorf("profile %q: no credentials found, expected aws_access_key_id and aws_secret_access_key, role_arn or credential_process", name)
+	}
+
+	if profile.MFASerial != "" {
+		return nil, fmt.Errorf("profile %q: mfa_serial is not supported as Terraform cannot prompt for a token code,"+
+			" use the provider assume_role block with serial_number and token_code instead", name)
+	}
+
+	stsclient, err := newCredentialsStsClient(c, awsCredentials.NewCredentials(provider))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("[DEBUG] Shared config profile %q assumes role %s", name, profile.RoleARN)
+	assumeRoleProvider := &stscreds.AssumeRoleProvider{
+		Client:          stsclient,
+		RoleARN:         profile.RoleARN,
+		RoleSessionName: profile.RoleSessionName,
+		Duration:        profile.Duration,
+		ExpiryWindow:    credentialsExpiryWindow,
+	}
+	if profile.ExternalID != "" {
+		assumeRoleProvider.ExternalID = aws.String(profile.ExternalID)
+	}
+
+	return assumeRoleProvider, nil
+}
+
+// credentialSourceProvider returns a credentials provider for the
+// credential_source of a profile.
+func credentialSourceProvider(c *Config, profile *sharedConfigProfile) (awsCredentials.Provider, error) {
+	client, err := c.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &aws.Config{
+		HTTPClient: client,
+	}
+
+	switch profile.CredentialSource {
+	case "Environment":
+		return &awsCredentials.EnvProvider{}, nil
+	case "Ec2InstanceMetadata":
+		setOptionalEndpoint(cfg)
+		return &ec2rolecreds.EC2RoleProvider{
+			Client: ec2metadata.New(session.New(cfg)),
+		}, nil
+	case "EcsContainer":
+		return defaults.RemoteCredProvider(*cfg, defaults.Handlers()), nil
+	}
+
+	return nil, fmt.Errorf("profile %q: unsupported credential_source %q, expected Environment, Ec2InstanceMetadata or EcsContainer",
+		profile.Name, profile.CredentialSource)
+}
+
+// processCredentialsProviderName is the name of the processCredentialsProvider.
+const processCredentialsProviderName = "ProcessCredentialsProvider"
+
+// processCredentialsTimeout is how long a credential_process may run.
+const processCredentialsTimeout = 1 * time.Minute
+
+// processCredentialsProvider retrieves credentials by running the
+// credential_process command of a profile, which prints them as JSON.
+// Credentials without an expiration are retrieved once; others are retrieved
+// again when they expire.
+type processCredentialsProvider struct {
+	awsCredentials.Expiry
+
+	command string
+
+	expires   bool
+	retrieved bool
+}
+
+// processCredentialsOutput is the JSON printed by a credential_process.
+type processCredentialsOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      *time.Time
+}
+
+func (p *processCredentialsProvider) Retrieve() (awsCredentials.Value, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), processCredentialsTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.command)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Printf("[DEBUG] Running credential_process %q", p.command)
+	stdout, err := cmd.Output()
+	if ctx.Err() == context.DeadlineExceeded {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			awserr.New("ProcessCredentialsErr", fmt.Sprintf("credential_process %q timed out after %s", p.command, processCredentialsTimeout), ctx.Err())
+	}
+	if err != nil {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			awserr.New("ProcessCredentialsErr", fmt.Sprintf("credential_process %q failed: %s", p.command, strings.TrimSpace(stderr.String())), err)
+	}
+
+	var output processCredentialsOutput
+	if err := json.Unmarshal(stdout, &output); err != nil {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			awserr.New("ProcessCredentialsErr", fmt.Sprintf("error parsing the output of credential_process %q", p.command), err)
+	}
+	if output.Version != 1 {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			awserr.New("ProcessCredentialsErr", fmt.Sprintf("credential_process %q returned unsupported version %d, expected 1", p.command, output.Version), nil)
+	}
+	if output.AccessKeyId == "" || output.SecretAccessKey == "" {
+		return awsCredentials.Value{ProviderName: processCredentialsProviderName},
+			awserr.New("ProcessCredentialsErr", fmt.Sprintf("credential_process %q returned no AccessKeyId or SecretAccessKey", p.command), nil)
+	}
+
+	p.retrieved = true
+	p.expires = output.Expiration != nil
+	if p.expires {
+		p.SetExpiration(*output.Expiration, credentialsExpiryWindow)
+	}
+
+	return awsCredentials.Value{
+		AccessKeyID:     output.AccessKeyId,
+		SecretAccessKey: output.SecretAccessKey,
+		SessionToken:    output.SessionToken,
+		ProviderName:    processCredentialsProviderName,
+	}, nil
+}
+
+func (p *processCredentialsProvider) IsExpired() bool {
+	if !p.expires {
+		return !p.retrieved
+	}
+
+	return p.Expiry.IsExpired()
+}
@@ -0,0 +1,407 @@
+package aws
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSharedConfigProvider(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	credentialsFile := writeSharedConfigTestFile(t, dir, "credentials", `
+[static]
+aws_access_key_id = AKIDcredentials
+aws_secret_access_key = secret-credentials
+
+[overridden]
+aws_access_key_id = AKIDoverridden
+aws_secret_access_key = secret-overridden
+`)
+	configFile := writeSharedConfigTestFile(t, dir, "config", `
+[default]
+aws_access_key_id = AKIDdefault
+aws_secret_access_key = secret-default
+
+[profile config]
+aws_access_key_id = AKIDconfig
+aws_secret_access_key = secret-config
+aws_session_token = token-config
+
+[profile overridden]
+aws_access_key_id = AKIDconfig
+aws_secret_access_key = secret-config
+`)
+
+	testCases := []struct {
+		Profile           string
+		ExpectedAccessKey string
+		ExpectedToken     string
+	}{
+		{
+			Profile:           "",
+			ExpectedAccessKey: "AKIDdefault",
+		},
+		{
+			Profile:           "static",
+			ExpectedAccessKey: "AKIDcredentials",
+		},
+		{
+			Profile:           "config",
+			ExpectedAccessKey: "AKIDconfig",
+			ExpectedToken:     "token-config",
+		},
+		{
+			Profile:           "overridden",
+			ExpectedAccessKey: "AKIDoverridden",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprintf("profile %q", testCase.Profile), func(t *testing.T) {
+			provider := &sharedConfigProvider{
+				config: &Config{
+					CredsFilename:    credentialsFile,
+					SharedConfigFile: configFile,
+				},
+				profile: testCase.Profile,
+			}
+
+			v, err := provider.Retrieve()
+			if err != nil {
+				t.Fatalf("Error retrieving credentials: %s", err)
+			}
+			if v.AccessKeyID != testCase.ExpectedAccessKey {
+				t.Fatalf("AccessKeyID mismatch, expected: (%s), got (%s)", testCase.ExpectedAccessKey, v.AccessKeyID)
+			}
+			if v.SessionToken != testCase.ExpectedToken {
+				t.Fatalf("SessionToken mismatch, expected: (%s), got (%s)", testCase.ExpectedToken, v.SessionToken)
+			}
+			if provider.IsExpired() {
+				t.Fatal("Expected static credentials not to expire")
+			}
+		})
+	}
+}
+
+func TestSharedConfigProvider_sourceProfile(t *testing.T) {
+	requests, ts := stsAssumeRoleMock(t, nil)
+	defer ts.Close()
+
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	configFile := writeSharedConfigTestFile(t, dir, "config", `
+[profile base]
+aws_access_key_id = AKIDbase
+aws_secret_access_key = secret-base
+
+[profile hub]
+role_arn = arn:aws:iam::111111111111:role/hub
+source_profile = base
+duration_seconds = 1800
+
+[profile workload]
+role_arn = arn:aws:iam::222222222222:role/workload
+source_profile = hub
+external_id = workload-external-id
+role_session_name = ci
+`)
+
+	provider := &sharedConfigProvider{
+		config: &Config{
+			Region:           "us-east-1",
+			Endpoints:        map[string]string{"sts": ts.URL},
+			CredsFilename:    filepath.Join(dir, "credentials"),
+			SharedConfigFile: configFile,
+		},
+		profile: "workload",
+	}
+
+	v, err := provider.Retrieve()
+	if err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if expected := "AKIDworkload"; v.AccessKeyID != expected {
+		t.Fatalf("AccessKeyID mismatch, expected: (%s), got (%s)", expected, v.AccessKeyID)
+	}
+
+	if len(*requests) != 2 {
+		t.Fatalf("Expected 2 AssumeRole requests, got %d", len(*requests))
+	}
+
+	hub := (*requests)[0]
+	if actual, expected := hub.Form.Get("RoleArn"), "arn:aws:iam::111111111111:role/hub"; actual != expected {
+		t.Fatalf("Hub RoleArn mismatch, expected: (%s), got (%s)", expected, actual)
+	}
+	if actual, expected := hub.Form.Get("DurationSeconds"), "1800"; actual != expected {
+		t.Fatalf("Hub DurationSeconds mismatch, expected: (%s), got (%s)", expected, actual)
+	}
+	if !strings.Contains(hub.Authorization, "Credential=AKIDbase/") {
+		t.Fatalf("Expected hub role to be assumed with the base profile credentials, got %q", hub.Authorization)
+	}
+
+	workload := (*requests)[1]
+	expectedParams := map[string]string{
+		"RoleArn":         "arn:aws:iam::222222222222:role/workload",
+		"ExternalId":      "workload-external-id",
+		"RoleSessionName": "ci",
+	}
+	for k, expected := range expectedParams {
+		if actual := workload.Form.Get(k); actual != expected {
+			t.Fatalf("Workload AssumeRole parameter %s mismatch, expected: (%s), got (%s)", k, expected, actual)
+		}
+	}
+	if !strings.Contains(workload.Authorization, "Credential=AKIDhub/") {
+		t.Fatalf("Expected workload role to be assumed with the hub role credentials, got %q", workload.Authorization)
+	}
+}
+
+func TestSharedConfigProvider_credentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test scripts require a POSIX shell")
+	}
+
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	expired := time.Now().Add(credentialsExpiryWindow / 2).UTC().Format(time.RFC3339)
+	configFile := writeSharedConfigTestFile(t, dir, "config", fmt.Sprintf(`
+[profile expiring]
+credential_process = printf '{"Version": 1, "AccessKeyId": "AKIDprocess", "SecretAccessKey": "secret-process", "SessionToken": "token-process", "Expiration": "%s"}'
+
+[profile expired]
+credential_process = printf '{"Version": 1, "AccessKeyId": "AKIDexpired", "SecretAccessKey": "secret-expired", "Expiration": "%s"}'
+
+[profile long-lived]
+credential_process = echo '{"Version": 1, "AccessKeyId": "AKIDlonglived", "SecretAccessKey": "secret-longlived"}'
+
+[profile hub]
+role_arn = arn:aws:iam::111111111111:role/hub
+source_profile = long-lived
+`, expiration, expired))
+
+	config := &Config{
+		CredsFilename:    filepath.Join(dir, "credentials"),
+		SharedConfigFile: configFile,
+	}
+
+	provider := &sharedConfigProvider{config: config, profile: "expiring"}
+	v, err := provider.Retrieve()
+	if err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if v.AccessKeyID != "AKIDprocess" || v.SecretAccessKey != "secret-process" || v.SessionToken != "token-process" {
+		t.Fatalf("Unexpected credentials: %#v", v)
+	}
+	if provider.IsExpired() {
+		t.Fatal("Expected credentials not to be expired")
+	}
+
+	provider = &sharedConfigProvider{config: config, profile: "expired"}
+	if _, err := provider.Retrieve(); err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if !provider.IsExpired() {
+		t.Fatal("Expected credentials within the expiry window to be expired")
+	}
+
+	provider = &sharedConfigProvider{config: config, profile: "long-lived"}
+	if _, err := provider.Retrieve(); err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if provider.IsExpired() {
+		t.Fatal("Expected credentials without an expiration not to expire")
+	}
+
+	requests, ts := stsAssumeRoleMock(t, nil)
+	defer ts.Close()
+	config.Region = "us-east-1"
+	config.Endpoints = map[string]string{"sts": ts.URL}
+
+	provider = &sharedConfigProvider{config: config, profile: "hub"}
+	if _, err := provider.Retrieve(); err != nil {
+		t.Fatalf("Error retrieving credentials: %s", err)
+	}
+	if len(*requests) != 1 || !strings.Contains((*requests)[0].Authorization, "Credential=AKIDlonglived/") {
+		t.Fatalf("Expected hub role to be assumed with the credential_process credentials, got %#v", *requests)
+	}
+}
+
+func TestSharedConfigProvider_errors(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process test scripts require a POSIX shell")
+	}
+
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	configFile := writeSharedConfigTestFile(t, dir, "config", `
+[profile loop-a]
+role_arn = arn:aws:iam::111111111111:role/a
+source_profile = loop-b
+
+[profile loop-b]
+role_arn = arn:aws:iam::111111111111:role/b
+source_profile = loop-a
+
+[profile missing-source]
+role_arn = arn:aws:iam::111111111111:role/missing
+source_profile = nonexistent
+
+[profile no-source]
+role_arn = arn:aws:iam::111111111111:role/no-source
+
+[profile mfa]
+role_arn = arn:aws:iam::111111111111:role/mfa
+source_profile = static
+mfa_serial = arn:aws:iam::111111111111:mfa/user
+
+[profile static]
+aws_access_key_id = AKIDstatic
+aws_secret_access_key = secret-static
+
+[profile bad-source]
+role_arn = arn:aws:iam::111111111111:role/bad-source
+credential_source = Somewhere
+
+[profile empty]
+region = us-west-2
+
+[profile process-failure]
+credential_process = sh -c 'echo token expired >&2; exit 1'
+
+[profile process-version]
+credential_process = echo '{"Version": 2, "AccessKeyId": "AKID", "SecretAccessKey": "secret"}'
+`)
+
+	testCases := []struct {
+		Profile       string
+		ExpectedError string
+	}{
+		{
+			Profile:       "nonexistent",
+			ExpectedError: `profile "nonexistent" not found`,
+		},
+		{
+			Profile:       "loop-a",
+			ExpectedError: `profile "loop-a": source_profile chain loops back to this profile`,
+		},
+		{
+			Profile:       "missing-source",
+			ExpectedError: `profile "nonexistent" not found`,
+		},
+		{
+			Profile:       "no-source",
+			ExpectedError: `profile "no-source": role_arn requires either source_profile or credential_source`,
+		},
+		{
+			Profile:       "mfa",
+			ExpectedError: `profile "mfa": mfa_serial is not supported`,
+		},
+		{
+			Profile:       "bad-source",
+			ExpectedError: `profile "bad-source": unsupported credential_source "Somewhere"`,
+		},
+		{
+			Profile:       "empty",
+			ExpectedError: `profile "empty": no credentials found`,
+		},
+		{
+			Profile:       "process-failure",
+			ExpectedError: "token expired",
+		},
+		{
+			Profile:       "process-version",
+			ExpectedError: "unsupported version 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Profile, func(t *testing.T) {
+			provider := &sharedConfigProvider{
+				config: &Config{
+					CredsFilename:    filepath.Join(dir, "credentials"),
+					SharedConfigFile: configFile,
+				},
+				profile: testCase.Profile,
+			}
+
+			_, err := provider.Retrieve()
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if !strings.Contains(err.Error(), fmt.Sprintf("shared config profile %q", testCase.Profile)) {
+				t.Fatalf("Expected error to name profile %q, got: %s", testCase.Profile, err)
+			}
+			if !strings.Contains(err.Error(), testCase.ExpectedError) {
+				t.Fatalf("Expected error to contain %q, got: %s", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func TestAWSGetCredentials_shouldErrorWithInvalidProfile(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+	defer invalidAwsEnv(t)()
+
+	dir, err := ioutil.TempDir("", "tf-aws-shared-config")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	configFile := writeSharedConfigTestFile(t, dir, "config", `
+[profile no-source]
+role_arn = arn:aws:iam::111111111111:role/no-source
+`)
+
+	creds, err := GetCredentials(&Config{
+		Profile:          "no-source",
+		CredsFilename:    filepath.Join(dir, "credentials"),
+		SharedConfigFile: configFile,
+	})
+	if err != nil {
+		t.Fatalf("Error gettings creds: %s", err)
+	}
+
+	_, err = creds.Get()
+	if err == nil {
+		t.Fatal("Expected an error with an invalid profile")
+	}
+	if !strings.Contains(err.Error(), `profile "no-source": role_arn requires either source_profile or credential_source`) {
+		t.Fatalf("Expected error to name the invalid profile, got: %s", err)
+	}
+}
+
+func writeSharedConfigTestFile(t *testing.T, dir, name, contents string) string {
+	filename := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(filename, []byte(contents), 0600); err != nil {
+		t.Fatalf("Error writing %s: %s", filename, err)
+	}
+
+	return filename
+}
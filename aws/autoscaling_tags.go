@@ -99,6 +99,32 @@ func setAutoscalingTags(conn *autoscaling.AutoScaling, d *schema.ResourceData) e
 		removeTags = append(removeTags, r...)
 	}
 
+	// Provider default tags are propagated at launch unless overridden
+	// by the tag or tags attributes
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
+		otag, ntag := d.GetChange("tag")
+		otags, ntags := d.GetChange("tags")
+
+		old, err := autoscalingTagsFromTagsAll(oraw.(map[string]interface{}), otag.(*schema.Set), otags.([]interface{}), resourceID)
+		if err != nil {
+			return err
+		}
+
+		new, err := autoscalingTagsFromTagsAll(nraw.(map[string]interface{}), ntag.(*schema.Set), ntags.([]interface{}), resourceID)
+		if err != nil {
+			return err
+		}
+
+		c, r, err := diffAutoscalingTags(old, new, resourceID)
+		if err != nil {
+			return err
+		}
+
+		createTags = append(createTags, c...)
+		removeTags = append(removeTags, r...)
+	}
+
 	// Set tags
 	if len(removeTags) > 0 {
 		log.Printf("[DEBUG] Removing autoscaling tags: %#v", removeTags)
@@ -292,6 +318,120 @@ func autoscalingTagDescriptionsToSlice(ts []*autoscaling.TagDescription) []map[s
 	return tags
 }
 
+// autoscalingTagKeys returns the keys of the tags configured in the tag and
+// tags attributes.
+func autoscalingTagKeys(tag *schema.Set, tags []interface{}) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for k := range setToMapByKey(tag, "key") {
+		keys[k] = struct{}{}
+	}
+	for _, v := range tags {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if k, ok := attr["key"].(string); ok {
+			keys[k] = struct{}{}
+		}
+	}
+
+	return keys
+}
+
+// autoscalingTagsAll returns the provider default tags overlaid with the
+// values of the tags configured in the tag and tags attributes.
+func autoscalingTagsAll(defaultTags map[string]interface{}, tag *schema.Set, tags []interface{}) map[string]interface{} {
+	configured := make(map[string]interface{})
+	for k, v := range setToMapByKey(tag, "key") {
+		configured[k] = v.(map[string]interface{})["value"]
+	}
+	for _, v := range tags {
+		attr, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if k, ok := attr["key"].(string); ok {
+			configured[k] = attr["value"]
+		}
+	}
+
+	return mergeDefaultTags(defaultTags, configured)
+}
+
+// autoscalingTagsFromTagsAll returns the tags in tags_all that are not
+// configured in the tag or tags attributes, i.e. the provider default tags.
+// These are always propagated at launch.
+func autoscalingTagsFromTagsAll(tagsAll map[string]interface{}, tag *schema.Set, tags []interface{}, resourceID string) ([]*autoscaling.Tag, error) {
+	keys := autoscalingTagKeys(tag, tags)
+
+	result := make([]*autoscaling.Tag, 0, len(tagsAll))
+	for k, v := range tagsAll {
+		if _, ok := keys[k]; ok {
+			continue
+		}
+
+		t, err := autoscalingTagFromMap(map[string]interface{}{
+			"key":                 k,
+			"value":               v,
+			"propagate_at_launch": true,
+		}, resourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if t != nil {
+			result = append(result, t)
+		}
+	}
+
+	return result, nil
+}
+
+// autoscalingTagDescriptionsWithoutDefaults returns the tags that do not
+// originate from the provider default tags.
+func autoscalingTagDescriptionsWithoutDefaults(ts []*autoscaling.TagDescription, defaultTags map[string]interface{}) []*autoscaling.TagDescription {
+	result := make([]*autoscaling.TagDescription, 0, len(ts))
+	for _, t := range ts {
+		if v, ok := defaultTags[aws.StringValue(t.Key)]; ok && v == aws.StringValue(t.Value) && aws.BoolValue(t.PropagateAtLaunch) {
+			continue
+		}
+		result = append(result, t)
+	}
+
+	return result
+}
+
+// autoscalingTagDescriptionsWithoutIgnored returns the tags whose keys are not
+// excluded by the provider ignore_tags.
+func autoscalingTagDescriptionsWithoutIgnored(ts []*autoscaling.TagDescription, ignoreTags *ignoreTagsConfig) []*autoscaling.TagDescription {
+	result := make([]*autoscaling.TagDescription, 0, len(ts))
+	for _, t := range ts {
+		if ignoreTags.ignored(aws.StringValue(t.Key)) {
+			continue
+		}
+		result = append(result, t)
+	}
+
+	return result
+}
+
+// autoscalingTagDescriptionsToTagsAll returns the key/value map of the tags
+// found on an Auto Scaling group that are either managed through the tag or
+// tags attributes or supplied by the provider default tags.
+func autoscalingTagDescriptionsToTagsAll(ts []*autoscaling.TagDescription, keys map[string]struct{}, defaultTags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, t := range ts {
+		k := aws.StringValue(t.Key)
+		_, configured := keys[k]
+		_, defaulted := defaultTags[k]
+		if configured || defaulted {
+			result[k] = aws.StringValue(t.Value)
+		}
+	}
+
+	return result
+}
+
 func setToMapByKey(s *schema.Set, key string) map[string]interface{} {
 	result := make(map[string]interface{})
 	for _, rawData := range s.List() {
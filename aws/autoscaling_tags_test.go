@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -111,6 +112,44 @@ func TestDiffAutoscalingTags(t *testing.T) {
 }
 
 // testAccCheckTags can be used to check the tags on a resource.
+func TestAutoscalingTagsFromTagsAll(t *testing.T) {
+	tag := schema.NewSet(autoscalingTagToHash, []interface{}{
+		map[string]interface{}{
+			"key":                 "Name",
+			"value":               "foo",
+			"propagate_at_launch": false,
+		},
+	})
+	tags := []interface{}{
+		map[string]interface{}{
+			"key":                 "Owner",
+			"value":               "Network",
+			"propagate_at_launch": "true",
+		},
+	}
+	tagsAll := map[string]interface{}{
+		"Environment": "Production",
+		"Name":        "foo",
+		"Owner":       "Network",
+	}
+
+	result, err := autoscalingTagsFromTagsAll(tagsAll, tag, tags, "foo")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"Environment": map[string]interface{}{
+			"key":                 "Environment",
+			"value":               "Production",
+			"propagate_at_launch": true,
+		},
+	}
+	if actual := autoscalingTagsToMap(result); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
 func testAccCheckAutoscalingTags(
 	ts *[]*autoscaling.TagDescription, key string, expected map[string]interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1,11 +1,9 @@
 package aws
 
 import (
-	"crypto/tls"
 	"errors"
 	"fmt"
 	"log"
-	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -44,6 +42,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/devicefarm"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/aws/aws-sdk-go/service/directoryservice"
+	"github.com/aws/aws-sdk-go/service/dlm"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ecr"
@@ -67,6 +66,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/inspector"
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
@@ -83,6 +83,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/aws/aws-sdk-go/service/route53"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/aws/aws-sdk-go/service/servicediscovery"
@@ -99,55 +100,82 @@ import (
 	"github.com/aws/aws-sdk-go/service/wafregional"
 	"github.com/aws/aws-sdk-go/service/workspaces"
 	"github.com/davecgh/go-spew/spew"
-	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform/helper/logging"
 	"github.com/hashicorp/terraform/terraform"
 )
 
+// AssumeRole holds the settings used to assume one of the roles in the
+// provider assume_role chain.
+type AssumeRole struct {
+	RoleARN           string
+	SessionName       string
+	ExternalID        string
+	Policy            string
+	PolicyARNs        []string
+	Duration          time.Duration
+	SerialNumber      string
+	TokenCode         string
+	Tags              map[string]string
+	TransitiveTagKeys []string
+}
+
+// AssumeRoleWithWebIdentity holds the settings used to obtain the credentials
+// of a role in exchange for a web identity token read from a file.
+type AssumeRoleWithWebIdentity struct {
+	RoleARN              string
+	SessionName          string
+	WebIdentityTokenFile string
+	Duration             time.Duration
+}
+
 type Config struct {
-	AccessKey     string
-	SecretKey     string
-	CredsFilename string
-	Profile       string
-	Token         string
-	Region        string
-	MaxRetries    int
-
-	AssumeRoleARN         string
-	AssumeRoleExternalID  string
-	AssumeRoleSessionName string
-	AssumeRolePolicy      string
+	AccessKey        string
+	SecretKey        string
+	CredsFilename    string
+	SharedConfigFile string
+	Profile          string
+	Token            string
+	Region           string
+	MaxRetries       int
+
+	// AssumeRoles are assumed in order, each using the credentials obtained
+	// from the one before it.
+	AssumeRoles []*AssumeRole
+
+	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentity
 
 	AllowedAccountIds   []interface{}
 	ForbiddenAccountIds []interface{}
 
-	AcmEndpoint              string
-	ApigatewayEndpoint       string
-	CloudFormationEndpoint   string
-	CloudWatchEndpoint       string
-	CloudWatchEventsEndpoint string
-	CloudWatchLogsEndpoint   string
-	DynamoDBEndpoint         string
-	DeviceFarmEndpoint       string
-	Ec2Endpoint              string
-	EcsEndpoint              string
-	AutoscalingEndpoint      string
-	EcrEndpoint              string
-	EfsEndpoint              string
-	EsEndpoint               string
-	ElbEndpoint              string
-	IamEndpoint              string
-	KinesisEndpoint          string
-	KmsEndpoint              string
-	LambdaEndpoint           string
-	RdsEndpoint              string
-	R53Endpoint              string
-	S3Endpoint               string
-	SnsEndpoint              string
-	SqsEndpoint              string
-	StsEndpoint              string
-	SsmEndpoint              string
-	Insecure                 bool
+	DefaultTags map[string]interface{}
+	IgnoreTags  *ignoreTagsConfig
+
+	// Endpoints holds the endpoint URL overrides keyed by service, as
+	// named in the provider endpoints block.
+	Endpoints map[string]string
+
+	// RateLimits holds the client-side request rate limits keyed by service,
+	// named as in the endpoints block.
+	RateLimits map[string]*RateLimit
+
+	Insecure bool
+
+	// HTTPProxy, HTTPSProxy and NoProxy override the proxy environment
+	// variables of the same names.
+	HTTPProxy  string
+	HTTPSProxy string
+	NoProxy    string
+
+	// CustomCABundle is the path to a PEM file holding the certificate
+	// authorities trusted instead of the system ones.
+	CustomCABundle string
+
+	// TLSMinVersion is the minimum TLS version, such as "1.2".
+	TLSMinVersion string
+
+	// APITraceFile is the path to a file to which a JSON line is appended
+	// for every AWS API call.
+	APITraceFile string
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -171,6 +199,7 @@ type AWSClient struct {
 	configconn            *configservice.ConfigService
 	daxconn               *dax.DAX
 	devicefarmconn        *devicefarm.DeviceFarm
+	dlmconn               *dlm.DLM
 	dmsconn               *databasemigrationservice.DatabaseMigrationService
 	dsconn                *directoryservice.DirectoryService
 	dynamodbconn          *dynamodb.DynamoDB
@@ -189,6 +218,7 @@ type AWSClient struct {
 	appautoscalingconn    *applicationautoscaling.ApplicationAutoScaling
 	autoscalingconn       *autoscaling.AutoScaling
 	s3conn                *s3.S3
+	sagemakerconn         *sagemaker.SageMaker
 	secretsmanagerconn    *secretsmanager.SecretsManager
 	scconn                *servicecatalog.ServiceCatalog
 	sesConn               *ses.SES
@@ -202,9 +232,13 @@ type AWSClient struct {
 	accountid             string
 	supportedplatforms    []string
 	region                string
+	defaultTags           map[string]interface{}
+	ignoreTagsConfig      *ignoreTagsConfig
+	regionalClients       *regionalClients
 	rdsconn               *rds.RDS
 	iamconn               *iam.IAM
 	kinesisconn           *kinesis.Kinesis
+	kinesisanalyticsconn  *kinesisanalytics.KinesisAnalytics
 	kmsconn               *kms.KMS
 	gameliftconn          *gamelift.GameLift
 	firehoseconn          *firehose.Firehose
@@ -245,6 +279,14 @@ type AWSClient struct {
 	pricingconn           *pricing.Pricing
 	pinpointconn          *pinpoint.Pinpoint
 	workspacesconn        *workspaces.WorkSpaces
+
+	// apiTracer traces the API calls made with the clients when
+	// api_trace_file is set, along with apiTraceAddress, the address of the
+	// Terraform resource the clients are used for. apiTraceBase is then the
+	// client shared by all resources that they were copied from.
+	apiTracer       *apiTracer
+	apiTraceAddress string
+	apiTraceBase    *AWSClient
 }
 
 func (c *AWSClient) S3() *s3.S3 {
@@ -278,6 +320,8 @@ func (c *Config) Client() (interface{}, error) {
 	// store AWS region in client struct, for region specific operations such as
 	// bucket storage in S3
 	client.region = c.Region
+	client.defaultTags = c.DefaultTags
+	client.ignoreTagsConfig = c.IgnoreTags
 
 	log.Println("[INFO] Building AWS auth structure")
 	creds, err := GetCredentials(c)
@@ -285,6 +329,11 @@ func (c *Config) Client() (interface{}, error) {
 		return nil, err
 	}
 
+	httpClient, err := c.newHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+
 	// define the AWS Session options
 	// Credentials or Profile will be set in the Options below
 	// MaxRetries may be set once we validate credentials
@@ -292,7 +341,7 @@ func (c *Config) Client() (interface{}, error) {
 		Config: aws.Config{
 			Region:           aws.String(c.Region),
 			MaxRetries:       aws.Int(0),
-			HTTPClient:       cleanhttp.DefaultClient(),
+			HTTPClient:       httpClient,
 			S3ForcePathStyle: aws.Bool(c.S3ForcePathStyle),
 		},
 	}
@@ -319,9 +368,7 @@ func (c *Config) Client() (interface{}, error) {
 				log.Printf("[INFO] Using session-derived AWS Auth")
 				opt.Config.Credentials = sess.Config.Credentials
 			} else {
-				log.Printf("[INFO] AWS Auth using Profile: %q", c.Profile)
-				opt.Profile = c.Profile
-				opt.SharedConfigState = session.SharedConfigEnable
+				return nil, fmt.Errorf("Error loading credentials for AWS Provider with profile %q: %s", c.Profile, err)
 			}
 		} else {
 			return nil, fmt.Errorf("Error loading credentials for AWS Provider: %s", err)
@@ -337,13 +384,6 @@ func (c *Config) Client() (interface{}, error) {
 		opt.Config.Logger = awsLogger{}
 	}
 
-	if c.Insecure {
-		transport := opt.Config.HTTPClient.Transport.(*http.Transport)
-		transport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true,
-		}
-	}
-
 	// create base session with no retries. MaxRetries will be set later
 	sess, err := session.NewSessionWithOptions(opt)
 	if err != nil {
@@ -361,6 +401,16 @@ func (c *Config) Client() (interface{}, error) {
 		sess.Handlers.UnmarshalError.PushFrontNamed(debugAuthFailure)
 	}
 
+	if c.APITraceFile != "" {
+		tracer, err := openAPITraceFile(c.APITraceFile)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[INFO] Tracing AWS API calls to %s", c.APITraceFile)
+		tracer.addHandlers(&sess.Handlers)
+		client.apiTracer = tracer
+	}
+
 	// if the desired number of retries is non-zero, update the session
 	if c.MaxRetries > 0 {
 		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
@@ -392,52 +442,42 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	})
 
+	// Each service gets its own copy of the session with its endpoint and its
+	// adaptive retryer, which rate limits the service when configured to and
+	// backs off harder as the service throttles requests.
+	throttling := newClientThrottling(c.RateLimits, c.MaxRetries)
+	serviceSess := func(service string) *session.Session {
+		return throttling.session(sess, service, c.endpoint(service))
+	}
+
+	// Regional resources can be managed in another region than the provider
+	// region, with clients built for that region when first needed.
+	client.regionalClients = newRegionalClients(c.SkipRegionValidation, func(service, region string) *session.Session {
+		return throttling.session(regionalSession(sess, region), service, c.endpoint(service))
+	})
+
 	// This restriction should only be used for Route53 sessions.
 	// Other resources that have restrictions should allow the API to fail, rather
 	// than Terraform abstracting the region for the user. This can lead to breaking
 	// changes if that resource is ever opened up to more regions.
-	r53Sess := sess.Copy(&aws.Config{Region: aws.String("us-east-1"), Endpoint: aws.String(c.R53Endpoint)})
-
-	// Some services have user-configurable endpoints
-	awsAcmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AcmEndpoint)})
-	awsApigatewaySess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ApigatewayEndpoint)})
-	awsCfSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudFormationEndpoint)})
-	awsCwSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEndpoint)})
-	awsCweSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchEventsEndpoint)})
-	awsCwlSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.CloudWatchLogsEndpoint)})
-	awsDynamoSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DynamoDBEndpoint)})
-	awsEc2Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.Ec2Endpoint)})
-	awsAutoscalingSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.AutoscalingEndpoint)})
-	awsEcrSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcrEndpoint)})
-	awsEcsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EcsEndpoint)})
-	awsEfsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EfsEndpoint)})
-	awsElbSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.ElbEndpoint)})
-	awsEsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.EsEndpoint)})
-	awsIamSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.IamEndpoint)})
-	awsLambdaSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.LambdaEndpoint)})
-	awsKinesisSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KinesisEndpoint)})
-	awsKmsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.KmsEndpoint)})
-	awsRdsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.RdsEndpoint)})
-	awsS3Sess := sess.Copy(&aws.Config{Endpoint: aws.String(c.S3Endpoint)})
-	awsSnsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SnsEndpoint)})
-	awsSqsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SqsEndpoint)})
-	awsStsSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.StsEndpoint)})
-	awsDeviceFarmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.DeviceFarmEndpoint)})
-	awsSsmSess := sess.Copy(&aws.Config{Endpoint: aws.String(c.SsmEndpoint)})
+	r53Config := &aws.Config{Region: aws.String("us-east-1")}
 
 	log.Println("[INFO] Initializing DeviceFarm SDK connection")
-	client.devicefarmconn = devicefarm.New(awsDeviceFarmSess)
+	client.devicefarmconn = devicefarm.New(serviceSess("devicefarm"))
+	client.dlmconn = dlm.New(serviceSess("dlm"))
 
 	// Beyond verifying credentials (if enabled), we use the next set of logic
 	// to determine two pieces of information required for manually assembling
 	// resource ARNs when they are not available in the service API:
 	//  * client.accountid
 	//  * client.partition
-	client.iamconn = iam.New(awsIamSess)
-	client.stsconn = sts.New(awsStsSess)
+	client.iamconn = iam.New(serviceSess("iam"))
+	client.stsconn = sts.New(serviceSess("sts"))
 
-	if c.AssumeRoleARN != "" {
-		client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(c.AssumeRoleARN)
+	if n := len(c.AssumeRoles); n > 0 {
+		client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(c.AssumeRoles[n-1].RoleARN)
+	} else if c.AssumeRoleWithWebIdentity != nil {
+		client.accountid, client.partition, _ = parseAccountIDAndPartitionFromARN(c.AssumeRoleWithWebIdentity.RoleARN)
 	}
 
 	// Validate credentials early and fail before we do any graph walking.
@@ -479,7 +519,7 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.ec2conn = ec2.New(awsEc2Sess)
+	client.ec2conn = ec2.New(serviceSess("ec2"))
 
 	if !c.SkipGetEC2Platforms {
 		supportedPlatforms, err := GetSupportedEC2Platforms(client.ec2conn)
@@ -492,86 +532,87 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
-	client.budgetconn = budgets.New(sess)
-	client.acmconn = acm.New(awsAcmSess)
-	client.acmpcaconn = acmpca.New(sess)
-	client.apigateway = apigateway.New(awsApigatewaySess)
-	client.appautoscalingconn = applicationautoscaling.New(sess)
-	client.autoscalingconn = autoscaling.New(awsAutoscalingSess)
-	client.cloud9conn = cloud9.New(sess)
-	client.cfconn = cloudformation.New(awsCfSess)
-	client.cloudfrontconn = cloudfront.New(sess)
-	client.cloudhsmv2conn = cloudhsmv2.New(sess)
-	client.cloudtrailconn = cloudtrail.New(sess)
-	client.cloudwatchconn = cloudwatch.New(awsCwSess)
-	client.cloudwatcheventsconn = cloudwatchevents.New(awsCweSess)
-	client.cloudwatchlogsconn = cloudwatchlogs.New(awsCwlSess)
-	client.codecommitconn = codecommit.New(sess)
-	client.codebuildconn = codebuild.New(sess)
-	client.codedeployconn = codedeploy.New(sess)
-	client.configconn = configservice.New(sess)
-	client.cognitoconn = cognitoidentity.New(sess)
-	client.cognitoidpconn = cognitoidentityprovider.New(sess)
-	client.codepipelineconn = codepipeline.New(sess)
-	client.daxconn = dax.New(awsDynamoSess)
-	client.dmsconn = databasemigrationservice.New(sess)
-	client.dsconn = directoryservice.New(sess)
-	client.dynamodbconn = dynamodb.New(awsDynamoSess)
-	client.ecrconn = ecr.New(awsEcrSess)
-	client.ecsconn = ecs.New(awsEcsSess)
-	client.efsconn = efs.New(awsEfsSess)
-	client.eksconn = eks.New(sess)
-	client.elasticacheconn = elasticache.New(sess)
-	client.elasticbeanstalkconn = elasticbeanstalk.New(sess)
-	client.elastictranscoderconn = elastictranscoder.New(sess)
-	client.elbconn = elb.New(awsElbSess)
-	client.elbv2conn = elbv2.New(awsElbSess)
-	client.emrconn = emr.New(sess)
-	client.esconn = elasticsearch.New(awsEsSess)
-	client.firehoseconn = firehose.New(sess)
-	client.fmsconn = fms.New(sess)
-	client.inspectorconn = inspector.New(sess)
-	client.gameliftconn = gamelift.New(sess)
-	client.glacierconn = glacier.New(sess)
-	client.guarddutyconn = guardduty.New(sess)
-	client.iotconn = iot.New(sess)
-	client.kinesisconn = kinesis.New(awsKinesisSess)
-	client.kmsconn = kms.New(awsKmsSess)
-	client.lambdaconn = lambda.New(awsLambdaSess)
-	client.lexmodelconn = lexmodelbuildingservice.New(sess)
-	client.lightsailconn = lightsail.New(sess)
-	client.macieconn = macie.New(sess)
-	client.mqconn = mq.New(sess)
-	client.neptuneconn = neptune.New(sess)
-	client.opsworksconn = opsworks.New(sess)
-	client.organizationsconn = organizations.New(sess)
-	client.r53conn = route53.New(r53Sess)
-	client.rdsconn = rds.New(awsRdsSess)
-	client.redshiftconn = redshift.New(sess)
-	client.simpledbconn = simpledb.New(sess)
-	client.s3conn = s3.New(awsS3Sess)
-	client.scconn = servicecatalog.New(sess)
-	client.sdconn = servicediscovery.New(sess)
-	client.sesConn = ses.New(sess)
-	client.secretsmanagerconn = secretsmanager.New(sess)
-	client.sfnconn = sfn.New(sess)
-	client.snsconn = sns.New(awsSnsSess)
-	client.sqsconn = sqs.New(awsSqsSess)
-	client.ssmconn = ssm.New(awsSsmSess)
-	client.storagegatewayconn = storagegateway.New(sess)
-	client.swfconn = swf.New(sess)
-	client.wafconn = waf.New(sess)
-	client.wafregionalconn = wafregional.New(sess)
-	client.batchconn = batch.New(sess)
-	client.glueconn = glue.New(sess)
-	client.athenaconn = athena.New(sess)
-	client.dxconn = directconnect.New(sess)
-	client.mediastoreconn = mediastore.New(sess)
-	client.appsyncconn = appsync.New(sess)
-	client.neptuneconn = neptune.New(sess)
-	client.pricingconn = pricing.New(sess)
-	client.pinpointconn = pinpoint.New(sess)
-	client.workspacesconn = workspaces.New(sess)
+	client.budgetconn = budgets.New(serviceSess("budgets"))
+	client.acmconn = acm.New(serviceSess("acm"))
+	client.acmpcaconn = acmpca.New(serviceSess("acmpca"))
+	client.apigateway = apigateway.New(serviceSess("apigateway"))
+	client.appautoscalingconn = applicationautoscaling.New(serviceSess("applicationautoscaling"))
+	client.autoscalingconn = autoscaling.New(serviceSess("autoscaling"))
+	client.cloud9conn = cloud9.New(serviceSess("cloud9"))
+	client.cfconn = cloudformation.New(serviceSess("cloudformation"))
+	client.cloudfrontconn = cloudfront.New(serviceSess("cloudfront"))
+	client.cloudhsmv2conn = cloudhsmv2.New(serviceSess("cloudhsm"))
+	client.cloudtrailconn = cloudtrail.New(serviceSess("cloudtrail"))
+	client.cloudwatchconn = cloudwatch.New(serviceSess("cloudwatch"))
+	client.cloudwatcheventsconn = cloudwatchevents.New(serviceSess("cloudwatchevents"))
+	client.cloudwatchlogsconn = cloudwatchlogs.New(serviceSess("cloudwatchlogs"))
+	client.codecommitconn = codecommit.New(serviceSess("codecommit"))
+	client.codebuildconn = codebuild.New(serviceSess("codebuild"))
+	client.codedeployconn = codedeploy.New(serviceSess("codedeploy"))
+	client.configconn = configservice.New(serviceSess("configservice"))
+	client.cognitoconn = cognitoidentity.New(serviceSess("cognitoidentity"))
+	client.cognitoidpconn = cognitoidentityprovider.New(serviceSess("cognitoidp"))
+	client.codepipelineconn = codepipeline.New(serviceSess("codepipeline"))
+	client.daxconn = dax.New(serviceSess("dax"))
+	client.dmsconn = databasemigrationservice.New(serviceSess("dms"))
+	client.dsconn = directoryservice.New(serviceSess("ds"))
+	client.dynamodbconn = dynamodb.New(serviceSess("dynamodb"))
+	client.ecrconn = ecr.New(serviceSess("ecr"))
+	client.ecsconn = ecs.New(serviceSess("ecs"))
+	client.efsconn = efs.New(serviceSess("efs"))
+	client.eksconn = eks.New(serviceSess("eks"))
+	client.elasticacheconn = elasticache.New(serviceSess("elasticache"))
+	client.elasticbeanstalkconn = elasticbeanstalk.New(serviceSess("elasticbeanstalk"))
+	client.elastictranscoderconn = elastictranscoder.New(serviceSess("elastictranscoder"))
+	client.elbconn = elb.New(serviceSess("elb"))
+	client.elbv2conn = elbv2.New(serviceSess("elb"))
+	client.emrconn = emr.New(serviceSess("emr"))
+	client.esconn = elasticsearch.New(serviceSess("es"))
+	client.firehoseconn = firehose.New(serviceSess("firehose"))
+	client.fmsconn = fms.New(serviceSess("fms"))
+	client.inspectorconn = inspector.New(serviceSess("inspector"))
+	client.gameliftconn = gamelift.New(serviceSess("gamelift"))
+	client.glacierconn = glacier.New(serviceSess("glacier"))
+	client.guarddutyconn = guardduty.New(serviceSess("guardduty"))
+	client.iotconn = iot.New(serviceSess("iot"))
+	client.kinesisconn = kinesis.New(serviceSess("kinesis"))
+	client.kinesisanalyticsconn = kinesisanalytics.New(serviceSess("kinesisanalytics"))
+	client.kmsconn = kms.New(serviceSess("kms"))
+	client.lambdaconn = lambda.New(serviceSess("lambda"))
+	client.lexmodelconn = lexmodelbuildingservice.New(serviceSess("lexmodels"))
+	client.lightsailconn = lightsail.New(serviceSess("lightsail"))
+	client.macieconn = macie.New(serviceSess("macie"))
+	client.mqconn = mq.New(serviceSess("mq"))
+	client.neptuneconn = neptune.New(serviceSess("neptune"))
+	client.opsworksconn = opsworks.New(serviceSess("opsworks"))
+	client.organizationsconn = organizations.New(serviceSess("organizations"))
+	client.r53conn = route53.New(serviceSess("r53"), r53Config)
+	client.rdsconn = rds.New(serviceSess("rds"))
+	client.redshiftconn = redshift.New(serviceSess("redshift"))
+	client.simpledbconn = simpledb.New(serviceSess("sdb"))
+	client.s3conn = s3.New(serviceSess("s3"))
+	client.sagemakerconn = sagemaker.New(serviceSess("sagemaker"))
+	client.scconn = servicecatalog.New(serviceSess("servicecatalog"))
+	client.sdconn = servicediscovery.New(serviceSess("servicediscovery"))
+	client.sesConn = ses.New(serviceSess("ses"))
+	client.secretsmanagerconn = secretsmanager.New(serviceSess("secretsmanager"))
+	client.sfnconn = sfn.New(serviceSess("stepfunctions"))
+	client.snsconn = sns.New(serviceSess("sns"))
+	client.sqsconn = sqs.New(serviceSess("sqs"))
+	client.ssmconn = ssm.New(serviceSess("ssm"))
+	client.storagegatewayconn = storagegateway.New(serviceSess("storagegateway"))
+	client.swfconn = swf.New(serviceSess("swf"))
+	client.wafconn = waf.New(serviceSess("waf"))
+	client.wafregionalconn = wafregional.New(serviceSess("wafregional"))
+	client.batchconn = batch.New(serviceSess("batch"))
+	client.glueconn = glue.New(serviceSess("glue"))
+	client.athenaconn = athena.New(serviceSess("athena"))
+	client.dxconn = directconnect.New(serviceSess("directconnect"))
+	client.mediastoreconn = mediastore.New(serviceSess("mediastore"))
+	client.appsyncconn = appsync.New(serviceSess("appsync"))
+	client.pricingconn = pricing.New(serviceSess("pricing"))
+	client.pinpointconn = pinpoint.New(serviceSess("pinpoint"))
+	client.workspacesconn = workspaces.New(serviceSess("workspaces"))
 
 	// Workaround for https://github.com/aws/aws-sdk-go/issues/1376
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
@@ -601,15 +642,7 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	})
 
-	// See https://github.com/aws/aws-sdk-go/pull/1276
-	client.dynamodbconn.Handlers.Retry.PushBack(func(r *request.Request) {
-		if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
-			return
-		}
-		if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
-			r.Retryable = aws.Bool(true)
-		}
-	})
+	client.dynamodbconn.Handlers.Retry.PushBack(retryDynamoDBSubscriberLimit)
 
 	client.kinesisconn.Handlers.Retry.PushBack(func(r *request.Request) {
 		if r.Operation.Name == "CreateStream" {
@@ -643,6 +676,25 @@ func hasEc2Classic(platforms []string) bool {
 	return false
 }
 
+// endpointFallbacks maps the endpoints block names of services whose clients
+// used the endpoint override of another service to that service. DAX clients
+// used the DynamoDB override before dax had its own.
+var endpointFallbacks = map[string]string{
+	"dax": "dynamodb",
+}
+
+// endpoint returns the endpoint override of service, or the override of the
+// service it falls back to when it has none.
+func (c *Config) endpoint(service string) string {
+	if endpoint := c.Endpoints[service]; endpoint != "" {
+		return endpoint
+	}
+	if fallback, ok := endpointFallbacks[service]; ok {
+		return c.Endpoints[fallback]
+	}
+	return ""
+}
+
 // ValidateRegion returns an error if the configured region is not a
 // valid aws region and nil otherwise.
 func (c *Config) ValidateRegion() error {
@@ -733,6 +785,18 @@ var debugAuthFailure = request.NamedHandler{
 	},
 }
 
+// retryDynamoDBSubscriberLimit retries the DynamoDB item writes that fail
+// because the subscriber limit is exceeded.
+// See https://github.com/aws/aws-sdk-go/pull/1276
+func retryDynamoDBSubscriberLimit(r *request.Request) {
+	if r.Operation.Name != "PutItem" && r.Operation.Name != "UpdateItem" && r.Operation.Name != "DeleteItem" {
+		return
+	}
+	if isAWSErr(r.Error, dynamodb.ErrCodeLimitExceededException, "Subscriber limit exceeded:") {
+		r.Retryable = aws.Bool(true)
+	}
+}
+
 type awsLogger struct{}
 
 func (l awsLogger) Log(args ...interface{}) {
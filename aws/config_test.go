@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -41,6 +42,205 @@ func TestGetSupportedEC2Platforms(t *testing.T) {
 	}
 }
 
+func TestConfigClient_endpoints(t *testing.T) {
+	// The endpoints block name whose override each client in AWSClient uses
+	expected := map[string]string{
+		"acmconn":               "acm",
+		"acmpcaconn":            "acmpca",
+		"apigateway":            "apigateway",
+		"appautoscalingconn":    "applicationautoscaling",
+		"appsyncconn":           "appsync",
+		"athenaconn":            "athena",
+		"autoscalingconn":       "autoscaling",
+		"batchconn":             "batch",
+		"budgetconn":            "budgets",
+		"cfconn":                "cloudformation",
+		"cloud9conn":            "cloud9",
+		"cloudfrontconn":        "cloudfront",
+		"cloudhsmv2conn":        "cloudhsm",
+		"cloudtrailconn":        "cloudtrail",
+		"cloudwatchconn":        "cloudwatch",
+		"cloudwatcheventsconn":  "cloudwatchevents",
+		"cloudwatchlogsconn":    "cloudwatchlogs",
+		"codebuildconn":         "codebuild",
+		"codecommitconn":        "codecommit",
+		"codedeployconn":        "codedeploy",
+		"codepipelineconn":      "codepipeline",
+		"cognitoconn":           "cognitoidentity",
+		"cognitoidpconn":        "cognitoidp",
+		"configconn":            "configservice",
+		"daxconn":               "dax",
+		"devicefarmconn":        "devicefarm",
+		"dlmconn":               "dlm",
+		"dmsconn":               "dms",
+		"dsconn":                "ds",
+		"dxconn":                "directconnect",
+		"dynamodbconn":          "dynamodb",
+		"ec2conn":               "ec2",
+		"ecrconn":               "ecr",
+		"ecsconn":               "ecs",
+		"efsconn":               "efs",
+		"eksconn":               "eks",
+		"elasticacheconn":       "elasticache",
+		"elasticbeanstalkconn":  "elasticbeanstalk",
+		"elastictranscoderconn": "elastictranscoder",
+		"elbconn":               "elb",
+		"elbv2conn":             "elb",
+		"emrconn":               "emr",
+		"esconn":                "es",
+		"firehoseconn":          "firehose",
+		"fmsconn":               "fms",
+		"gameliftconn":          "gamelift",
+		"glacierconn":           "glacier",
+		"glueconn":              "glue",
+		"guarddutyconn":         "guardduty",
+		"iamconn":               "iam",
+		"inspectorconn":         "inspector",
+		"iotconn":               "iot",
+		"kinesisconn":           "kinesis",
+		"kinesisanalyticsconn":  "kinesisanalytics",
+		"kmsconn":               "kms",
+		"lambdaconn":            "lambda",
+		"lexmodelconn":          "lexmodels",
+		"lightsailconn":         "lightsail",
+		"macieconn":             "macie",
+		"mediastoreconn":        "mediastore",
+		"mqconn":                "mq",
+		"neptuneconn":           "neptune",
+		"opsworksconn":          "opsworks",
+		"organizationsconn":     "organizations",
+		"pinpointconn":          "pinpoint",
+		"pricingconn":           "pricing",
+		"r53conn":               "r53",
+		"rdsconn":               "rds",
+		"redshiftconn":          "redshift",
+		"s3conn":                "s3",
+		"sagemakerconn":         "sagemaker",
+		"scconn":                "servicecatalog",
+		"sdconn":                "servicediscovery",
+		"secretsmanagerconn":    "secretsmanager",
+		"sesConn":               "ses",
+		"sfnconn":               "stepfunctions",
+		"simpledbconn":          "sdb",
+		"snsconn":               "sns",
+		"sqsconn":               "sqs",
+		"ssmconn":               "ssm",
+		"storagegatewayconn":    "storagegateway",
+		"stsconn":               "sts",
+		"swfconn":               "swf",
+		"wafconn":               "waf",
+		"wafregionalconn":       "wafregional",
+		"workspacesconn":        "workspaces",
+	}
+
+	endpoints := make(map[string]string)
+	for _, endpointServiceName := range endpointServiceNames {
+		endpoints[endpointServiceName] = fmt.Sprintf("https://%s.example.com", endpointServiceName)
+	}
+
+	c := &Config{
+		AccessKey:               "accessKey",
+		SecretKey:               "secretKey",
+		Region:                  "us-west-2",
+		Endpoints:               endpoints,
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRegionValidation:    true,
+		SkipRequestingAccountId: true,
+	}
+
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("Error creating client: %s", err)
+	}
+
+	v := reflect.ValueOf(raw).Elem()
+	clients := 0
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		field := v.Field(i)
+		if field.Kind() != reflect.Ptr || field.Type().Elem().Kind() != reflect.Struct {
+			continue
+		}
+		if f, ok := field.Type().Elem().FieldByName("Client"); !ok || f.Type != reflect.TypeOf(&client.Client{}) {
+			continue
+		}
+		clients++
+
+		if field.IsNil() {
+			t.Errorf("AWSClient.%s: client not constructed", name)
+			continue
+		}
+		clientField := field.Elem().FieldByName("Client")
+
+		endpointServiceName, ok := expected[name]
+		if !ok {
+			t.Errorf("AWSClient.%s: no endpoints block name expected, add one to the endpoints block and this test", name)
+			continue
+		}
+		if actual := clientField.Elem().FieldByName("ClientInfo").FieldByName("Endpoint").String(); actual != endpoints[endpointServiceName] {
+			t.Errorf("AWSClient.%s: expected endpoint %q, got %q", name, endpoints[endpointServiceName], actual)
+		}
+	}
+
+	if clients != len(expected) {
+		t.Errorf("Expected %d clients in AWSClient, found %d", len(expected), clients)
+	}
+
+	for _, endpointServiceName := range expected {
+		if _, ok := endpoints[endpointServiceName]; !ok {
+			t.Errorf("Endpoints block is missing %q", endpointServiceName)
+		}
+	}
+}
+
+func TestConfigEndpoint(t *testing.T) {
+	cases := []struct {
+		Endpoints map[string]string
+		Service   string
+		Expected  string
+	}{
+		{
+			Endpoints: map[string]string{"dynamodb": "http://localhost:8000"},
+			Service:   "dynamodb",
+			Expected:  "http://localhost:8000",
+		},
+		{
+			Endpoints: map[string]string{"dynamodb": "http://localhost:8000"},
+			Service:   "dax",
+			Expected:  "http://localhost:8000",
+		},
+		{
+			Endpoints: map[string]string{"dax": "http://localhost:8111", "dynamodb": "http://localhost:8000"},
+			Service:   "dax",
+			Expected:  "http://localhost:8111",
+		},
+		{
+			Endpoints: map[string]string{"dax": "", "dynamodb": "http://localhost:8000"},
+			Service:   "dax",
+			Expected:  "http://localhost:8000",
+		},
+		{
+			Endpoints: map[string]string{"elb": "http://localhost:4566"},
+			Service:   "elb",
+			Expected:  "http://localhost:4566",
+		},
+		{
+			Endpoints: map[string]string{"dynamodb": "http://localhost:8000"},
+			Service:   "s3",
+			Expected:  "",
+		},
+	}
+
+	for i, tc := range cases {
+		c := &Config{Endpoints: tc.Endpoints}
+		if actual := c.endpoint(tc.Service); actual != tc.Expected {
+			t.Fatalf("%d: expected endpoint of %q to be %q, got %q", i, tc.Service, tc.Expected, actual)
+		}
+	}
+}
+
 // getMockedAwsApiSession establishes a httptest server to simulate behaviour
 // of a real AWS API server
 func getMockedAwsApiSession(svcName string, endpoints []*awsMockEndpoint) (func(), *session.Session, error) {
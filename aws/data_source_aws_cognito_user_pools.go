@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -44,13 +43,7 @@ func dataSourceAwsCognitoUserPoolsRead(d *schema.ResourceData, meta interface{})
 	for _, pool := range pools {
 		if name == aws.StringValue(pool.Name) {
 			id := aws.StringValue(pool.Id)
-			arn := arn.ARN{
-				Partition: meta.(*AWSClient).partition,
-				Service:   "cognito-idp",
-				Region:    meta.(*AWSClient).region,
-				AccountID: meta.(*AWSClient).accountid,
-				Resource:  fmt.Sprintf("userpool/%s", id),
-			}.String()
+			arn := meta.(*AWSClient).regionalArn("cognito-idp", fmt.Sprintf("userpool/%s", id))
 
 			ids = append(ids, id)
 			arns = append(arns, arn)
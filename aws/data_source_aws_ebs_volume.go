@@ -5,7 +5,6 @@ import (
 	"log"
 	"sort"
 
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -127,14 +126,8 @@ func volumeDescriptionAttributes(d *schema.ResourceData, client *AWSClient, volu
 	d.SetId(*volume.VolumeId)
 	d.Set("volume_id", volume.VolumeId)
 
-	arn := arn.ARN{
-		Partition: client.partition,
-		Region:    client.region,
-		Service:   "ec2",
-		AccountID: client.accountid,
-		Resource:  fmt.Sprintf("volume/%s", d.Id()),
-	}
-	d.Set("arn", arn.String())
+	arn := client.regionalArn("ec2", fmt.Sprintf("volume/%s", d.Id()))
+	d.Set("arn", arn)
 
 	d.Set("availability_zone", volume.AvailabilityZone)
 	d.Set("encrypted", volume.Encrypted)
@@ -0,0 +1,240 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// fmsPolicyComplianceStatusNotEvaluated is the compliance status of the member
+// accounts whose compliance with a policy has not been evaluated yet.
+const fmsPolicyComplianceStatusNotEvaluated = "NOT_EVALUATED"
+
+func dataSourceAwsFmsPolicyCompliance() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAwsFmsPolicyComplianceRead,
+
+		Schema: map[string]*schema.Schema{
+			"compliant": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"evaluated_account_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"member_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+			"member_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compliance_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"violator_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"non_compliant_accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"policy_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"violators": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"violation_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAwsFmsPolicyComplianceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+	policyID := d.Get("policy_id").(string)
+	memberAccount := d.Get("member_account").(string)
+
+	var statuses []*fms.PolicyComplianceStatus
+	input := &fms.ListComplianceStatusInput{
+		PolicyId: aws.String(policyID),
+	}
+	for {
+		log.Printf("[DEBUG] Listing FMS Policy compliance: %s", input)
+		output, err := conn.ListComplianceStatus(input)
+		if err != nil {
+			return fmt.Errorf("error listing FMS Policy (%s) compliance: %s", policyID, err)
+		}
+
+		for _, status := range output.PolicyComplianceStatusList {
+			if memberAccount != "" && aws.StringValue(status.MemberAccount) != memberAccount {
+				continue
+			}
+			statuses = append(statuses, status)
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	if memberAccount != "" && len(statuses) == 0 {
+		return fmt.Errorf("no FMS Policy (%s) compliance found for member account %s", policyID, memberAccount)
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return aws.StringValue(statuses[i].MemberAccount) < aws.StringValue(statuses[j].MemberAccount)
+	})
+
+	memberAccounts := make([]interface{}, 0, len(statuses))
+	complianceStatuses := make([]string, 0, len(statuses))
+	nonCompliantAccounts := make([]string, 0)
+	violators := make([]interface{}, 0)
+	for _, status := range statuses {
+		accountID := aws.StringValue(status.MemberAccount)
+		complianceStatus, violatorCount := fmsPolicyComplianceStatus(status.EvaluationResults)
+		complianceStatuses = append(complianceStatuses, complianceStatus)
+
+		m := map[string]interface{}{
+			"account_id":        accountID,
+			"compliance_status": complianceStatus,
+			"violator_count":    violatorCount,
+		}
+		if status.LastUpdated != nil {
+			m["last_updated"] = aws.TimeValue(status.LastUpdated).Format(time.RFC3339)
+		}
+		memberAccounts = append(memberAccounts, m)
+
+		if complianceStatus != fms.PolicyComplianceStatusTypeNonCompliant {
+			continue
+		}
+		nonCompliantAccounts = append(nonCompliantAccounts, accountID)
+
+		// The resources in violation are only detailed account by account
+		output, err := conn.GetComplianceDetail(&fms.GetComplianceDetailInput{
+			MemberAccount: aws.String(accountID),
+			PolicyId:      aws.String(policyID),
+		})
+		if err != nil {
+			return fmt.Errorf("error reading FMS Policy (%s) compliance detail for member account %s: %s", policyID, accountID, err)
+		}
+		if output.PolicyComplianceDetail == nil {
+			continue
+		}
+
+		for _, violator := range output.PolicyComplianceDetail.Violators {
+			violators = append(violators, map[string]interface{}{
+				"account_id":       accountID,
+				"resource_id":      aws.StringValue(violator.ResourceId),
+				"resource_type":    aws.StringValue(violator.ResourceType),
+				"violation_reason": aws.StringValue(violator.ViolationReason),
+			})
+		}
+	}
+
+	compliant, evaluatedAccountCount := fmsPolicyCompliant(complianceStatuses)
+
+	d.SetId(policyID)
+	d.Set("compliant", compliant)
+	d.Set("evaluated_account_count", evaluatedAccountCount)
+
+	if err := d.Set("member_accounts", memberAccounts); err != nil {
+		return fmt.Errorf("error setting member_accounts: %s", err)
+	}
+
+	if err := d.Set("non_compliant_accounts", nonCompliantAccounts); err != nil {
+		return fmt.Errorf("error setting non_compliant_accounts: %s", err)
+	}
+
+	if err := d.Set("violators", violators); err != nil {
+		return fmt.Errorf("error setting violators: %s", err)
+	}
+
+	return nil
+}
+
+// fmsPolicyCompliant returns whether the member accounts with the given
+// compliance statuses comply with a policy, along with the number of them
+// whose compliance has been evaluated. They only comply when all of them have
+// been evaluated, and there is at least one, so that a policy which has not
+// been evaluated yet, or has no account in its scope, is not reported as
+// complied with.
+func fmsPolicyCompliant(statuses []string) (bool, int) {
+	evaluated := 0
+	compliant := len(statuses) > 0
+	for _, status := range statuses {
+		if status != fmsPolicyComplianceStatusNotEvaluated {
+			evaluated++
+		}
+		if status != fms.PolicyComplianceStatusTypeCompliant {
+			compliant = false
+		}
+	}
+
+	return compliant, evaluated
+}
+
+// fmsPolicyComplianceStatus returns the compliance status of a member account,
+// which is non-compliant when any of its evaluation results is, or not
+// evaluated when it has none, along with its number of resources in violation.
+func fmsPolicyComplianceStatus(results []*fms.EvaluationResult) (string, int) {
+	if len(results) == 0 {
+		return fmsPolicyComplianceStatusNotEvaluated, 0
+	}
+
+	status := fms.PolicyComplianceStatusTypeCompliant
+	violatorCount := 0
+	for _, result := range results {
+		if aws.StringValue(result.ComplianceStatus) == fms.PolicyComplianceStatusTypeNonCompliant {
+			status = fms.PolicyComplianceStatusTypeNonCompliant
+		}
+		violatorCount += int(aws.Int64Value(result.ViolatorCount))
+	}
+
+	return status, violatorCount
+}
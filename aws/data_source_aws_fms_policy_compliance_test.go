@@ -0,0 +1,137 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSFmsPolicyComplianceDataSource_basic(t *testing.T) {
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	dataSourceName := "data.aws_fms_policy_compliance.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccFmsAdminAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyComplianceDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "policy_id", "aws_fms_policy.test", "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "compliant"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "evaluated_account_count"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "member_accounts.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "violators.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestFmsPolicyComplianceStatus(t *testing.T) {
+	testCases := []struct {
+		results               []*fms.EvaluationResult
+		expectedStatus        string
+		expectedViolatorCount int
+	}{
+		{
+			results:               nil,
+			expectedStatus:        fmsPolicyComplianceStatusNotEvaluated,
+			expectedViolatorCount: 0,
+		},
+		{
+			results: []*fms.EvaluationResult{
+				{
+					ComplianceStatus: aws.String(fms.PolicyComplianceStatusTypeCompliant),
+					ViolatorCount:    aws.Int64(0),
+				},
+			},
+			expectedStatus:        fms.PolicyComplianceStatusTypeCompliant,
+			expectedViolatorCount: 0,
+		},
+		{
+			results: []*fms.EvaluationResult{
+				{
+					ComplianceStatus: aws.String(fms.PolicyComplianceStatusTypeCompliant),
+					ViolatorCount:    aws.Int64(0),
+				},
+				{
+					ComplianceStatus: aws.String(fms.PolicyComplianceStatusTypeNonCompliant),
+					ViolatorCount:    aws.Int64(2),
+				},
+				{
+					ComplianceStatus: aws.String(fms.PolicyComplianceStatusTypeNonCompliant),
+					ViolatorCount:    aws.Int64(1),
+				},
+			},
+			expectedStatus:        fms.PolicyComplianceStatusTypeNonCompliant,
+			expectedViolatorCount: 3,
+		},
+	}
+
+	for i, tc := range testCases {
+		status, violatorCount := fmsPolicyComplianceStatus(tc.results)
+		if status != tc.expectedStatus {
+			t.Errorf("test case %d: got status %s, expected %s", i, status, tc.expectedStatus)
+		}
+		if violatorCount != tc.expectedViolatorCount {
+			t.Errorf("test case %d: got %d violators, expected %d", i, violatorCount, tc.expectedViolatorCount)
+		}
+	}
+}
+
+func TestFmsPolicyCompliant(t *testing.T) {
+	testCases := []struct {
+		statuses          []string
+		expectedCompliant bool
+		expectedEvaluated int
+	}{
+		{
+			statuses:          nil,
+			expectedCompliant: false,
+			expectedEvaluated: 0,
+		},
+		{
+			statuses:          []string{fmsPolicyComplianceStatusNotEvaluated},
+			expectedCompliant: false,
+			expectedEvaluated: 0,
+		},
+		{
+			statuses:          []string{fms.PolicyComplianceStatusTypeCompliant, fms.PolicyComplianceStatusTypeCompliant},
+			expectedCompliant: true,
+			expectedEvaluated: 2,
+		},
+		{
+			statuses:          []string{fms.PolicyComplianceStatusTypeCompliant, fmsPolicyComplianceStatusNotEvaluated},
+			expectedCompliant: false,
+			expectedEvaluated: 1,
+		},
+		{
+			statuses:          []string{fms.PolicyComplianceStatusTypeCompliant, fms.PolicyComplianceStatusTypeNonCompliant},
+			expectedCompliant: false,
+			expectedEvaluated: 2,
+		},
+	}
+
+	for i, tc := range testCases {
+		compliant, evaluated := fmsPolicyCompliant(tc.statuses)
+		if compliant != tc.expectedCompliant {
+			t.Errorf("test case %d: got compliant %t, expected %t", i, compliant, tc.expectedCompliant)
+		}
+		if evaluated != tc.expectedEvaluated {
+			t.Errorf("test case %d: got %d evaluated accounts, expected %d", i, evaluated, tc.expectedEvaluated)
+		}
+	}
+}
+
+func testAccAWSFmsPolicyComplianceDataSourceConfig(rName string) string {
+	return testAccAWSFmsPolicyConfig(rName, false) + `
+data "aws_fms_policy_compliance" "test" {
+  policy_id = "${aws_fms_policy.test.id}"
+}
+`
+}
@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAWSLexBotDataSource_basic(t *testing.T) {
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	dataSourceName := "data.aws_lex_bot.test"
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "checksum", resourceName, "checksum"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "child_directed", resourceName, "child_directed"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "idle_session_ttl_in_seconds", resourceName, "idle_session_ttl_in_seconds"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "latest_version", resourceName, "version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "locale", resourceName, "locale"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttr(dataSourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSLexBotDataSourceConfig(rName string) string {
+	return testAccAWSLexBotConfig(rName, "test", "BUILD", true) + `
+data "aws_lex_bot" "test" {
+  name = "${aws_lex_bot.test.name}"
+}
+`
+}
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -61,14 +60,8 @@ func dataAwsSsmParameterRead(d *schema.ResourceData, meta interface{}) error {
 	param := resp.Parameter
 	d.SetId(*param.Name)
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "ssm",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("parameter/%s", strings.TrimPrefix(d.Id(), "/")),
-	}
-	d.Set("arn", arn.String())
+	arn := meta.(*AWSClient).regionalArn("ssm", fmt.Sprintf("parameter/%s", strings.TrimPrefix(d.Id(), "/")))
+	d.Set("arn", arn)
 	d.Set("name", param.Name)
 	d.Set("type", param.Type)
 	d.Set("value", param.Value)
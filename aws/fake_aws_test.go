@@ -0,0 +1,572 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// fakeAWSRegion is the region that clients of the fake AWS server are
+// configured with, both when recording and when replaying fixtures.
+const fakeAWSRegion = "us-west-2"
+
+// fakeAWSRecordEnvVar enables recording mode when set to a non-empty value.
+const fakeAWSRecordEnvVar = "TF_AWS_FAKE_RECORD"
+
+// fakeAWSEndpointsIDs maps endpoints block service names to the endpoint IDs
+// used by the SDK resolver, for the names that differ. These are only needed
+// to find the real service when recording.
+var fakeAWSEndpointsIDs = map[string]string{
+	"applicationautoscaling": "application-autoscaling",
+	"cloudwatch":             "monitoring",
+	"cloudwatchevents":       "events",
+	"cloudwatchlogs":         "logs",
+	"cognitoidentity":        "cognito-identity",
+	"cognitoidp":             "cognito-idp",
+	"configservice":          "config",
+	"elb":                    "elasticloadbalancing",
+	"lexmodels":              "models.lex",
+	"r53":                    "route53",
+	"ses":                    "email",
+	"stepfunctions":          "states",
+}
+
+// fakeAWS is an in-process stand-in for the AWS service endpoints, used to run
+// resource CRUD functions without an AWS account.
+//
+// Each service is served under its own path prefix, e.g. /ec2 and /s3, so a
+// single server can back every client through the provider endpoints. By
+// default requests are answered from a fixture of recorded interactions: each
+// request is matched by service and operation against the first interaction
+// that has not yet been replayed. Request bodies are kept in fixtures for
+// reference but are not compared, as they may contain idempotency tokens.
+//
+// When TF_AWS_FAKE_RECORD is set, requests are instead forwarded to the real
+// service endpoints, re-signed with the credentials found in the environment,
+// and the interactions are written to the fixture when the server is closed.
+type fakeAWS struct {
+	t       *testing.T
+	server  *httptest.Server
+	fixture string
+	record  bool
+
+	// credentials signs forwarded requests and resolve finds the real
+	// endpoint of a service when recording.
+	credentials *credentials.Credentials
+	resolve     func(service string) (endpoints.ResolvedEndpoint, error)
+
+	mu           sync.Mutex
+	interactions []*fakeAWSInteraction
+	replayed     []bool
+}
+
+type fakeAWSFixture struct {
+	Interactions []*fakeAWSInteraction `json:"interactions"`
+}
+
+type fakeAWSInteraction struct {
+	Service   string          `json:"service"`
+	Operation string          `json:"operation"`
+	Request   fakeAWSRequest  `json:"request"`
+	Response  fakeAWSResponse `json:"response"`
+}
+
+type fakeAWSRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Body   string `json:"body,omitempty"`
+}
+
+type fakeAWSResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// newFakeAWS starts a fake AWS server for the named fixture in
+// test-fixtures/fake-aws, recording it instead when TF_AWS_FAKE_RECORD is set.
+// The server must be closed with Close.
+func newFakeAWS(t *testing.T, fixture string) *fakeAWS {
+	path := filepath.Join("test-fixtures", "fake-aws", fixture+".json")
+
+	if os.Getenv(fakeAWSRecordEnvVar) == "" {
+		return startFakeAWS(t, path, false)
+	}
+
+	sess, err := session.NewSession()
+	if err != nil {
+		t.Fatalf("error creating AWS session for recording: %s", err)
+	}
+
+	f := startFakeAWS(t, path, true)
+	f.credentials = sess.Config.Credentials
+	return f
+}
+
+func startFakeAWS(t *testing.T, fixture string, record bool) *fakeAWS {
+	f := &fakeAWS{
+		t:       t,
+		fixture: fixture,
+		record:  record,
+		resolve: func(service string) (endpoints.ResolvedEndpoint, error) {
+			if id, ok := fakeAWSEndpointsIDs[service]; ok {
+				service = id
+			}
+			return endpoints.DefaultResolver().EndpointFor(service, fakeAWSRegion)
+		},
+	}
+
+	if !record {
+		b, err := ioutil.ReadFile(fixture)
+		if err != nil {
+			t.Fatalf("error reading fake AWS fixture (set %s to record it): %s", fakeAWSRecordEnvVar, err)
+		}
+
+		var v fakeAWSFixture
+		if err := json.Unmarshal(b, &v); err != nil {
+			t.Fatalf("error parsing fake AWS fixture %s: %s", fixture, err)
+		}
+		f.interactions = v.Interactions
+		f.replayed = make([]bool, len(v.Interactions))
+	}
+
+	f.server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+	return f
+}
+
+// Endpoints returns an endpoint for every service supported by the provider
+// endpoints block, all served by the fake. Routing every service to the fake
+// means an unexpected call fails the test rather than reaching AWS.
+func (f *fakeAWS) Endpoints() map[string]string {
+	m := make(map[string]string, len(endpointServiceNames))
+	for _, service := range endpointServiceNames {
+		m[service] = f.server.URL + "/" + service
+	}
+	return m
+}
+
+// Client returns an AWSClient whose service clients all use the fake. The
+// credentials are validated as usual, so every fixture begins with the
+// GetCallerIdentity call that provides the account ID.
+func (f *fakeAWS) Client() *AWSClient {
+	c := &Config{
+		AccessKey:            "fake-access-key",
+		SecretKey:            "fake-secret-key",
+		Region:               fakeAWSRegion,
+		Endpoints:            f.Endpoints(),
+		S3ForcePathStyle:     true,
+		SkipGetEC2Platforms:  true,
+		SkipMetadataApiCheck: true,
+		SkipRegionValidation: true,
+	}
+
+	client, err := c.Client()
+	if err != nil {
+		f.t.Fatalf("error creating fake AWS client: %s", err)
+	}
+	return client.(*AWSClient)
+}
+
+// Close stops the server. When recording, the fixture is written; when
+// replaying, any interaction that was not replayed fails the test.
+func (f *fakeAWS) Close() {
+	f.server.Close()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.record {
+		b, err := json.MarshalIndent(&fakeAWSFixture{Interactions: f.interactions}, "", "  ")
+		if err != nil {
+			f.t.Fatalf("error encoding fake AWS fixture: %s", err)
+		}
+		if err := os.MkdirAll(filepath.Dir(f.fixture), 0755); err != nil {
+			f.t.Fatalf("error creating fake AWS fixture directory: %s", err)
+		}
+		if err := ioutil.WriteFile(f.fixture, append(b, '\n'), 0644); err != nil {
+			f.t.Fatalf("error writing fake AWS fixture: %s", err)
+		}
+		return
+	}
+
+	for i, interaction := range f.interactions {
+		if !f.replayed[i] {
+			f.t.Errorf("fake AWS interaction %d (%s %s) was not replayed", i, interaction.Service, interaction.Operation)
+		}
+	}
+}
+
+func (f *fakeAWS) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	service := parts[0]
+	path := "/"
+	if len(parts) > 1 {
+		path += parts[1]
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		f.t.Errorf("fake AWS: error reading %s request body: %s", service, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	interaction := &fakeAWSInteraction{
+		Service:   service,
+		Operation: fakeAWSOperation(r, path, body),
+		Request: fakeAWSRequest{
+			Method: r.Method,
+			Path:   path,
+			Body:   string(body),
+		},
+	}
+
+	if f.record {
+		resp, err := f.forward(service, r, path, body)
+		if err != nil {
+			f.t.Errorf("fake AWS: error forwarding %s %s: %s", service, interaction.Operation, err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		interaction.Response = *resp
+
+		f.mu.Lock()
+		f.interactions = append(f.interactions, interaction)
+		f.mu.Unlock()
+	} else {
+		recorded := f.next(service, interaction.Operation)
+		if recorded == nil {
+			f.t.Errorf("fake AWS: no recorded response for %s %s: %s %s\n%s",
+				service, interaction.Operation, r.Method, path, body)
+			http.Error(w, fmt.Sprintf("fake AWS: no recorded response for %s %s", service, interaction.Operation), http.StatusBadRequest)
+			return
+		}
+		interaction.Response = recorded.Response
+	}
+
+	for k, v := range interaction.Response.Headers {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(interaction.Response.StatusCode)
+	w.Write([]byte(interaction.Response.Body))
+}
+
+// next returns the first interaction not yet replayed for the operation.
+func (f *fakeAWS) next(service, operation string) *fakeAWSInteraction {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, interaction := range f.interactions {
+		if !f.replayed[i] && interaction.Service == service && interaction.Operation == operation {
+			f.replayed[i] = true
+			return interaction
+		}
+	}
+	return nil
+}
+
+// forward sends a request to the real service endpoint, re-signed for it, and
+// returns the response to record.
+func (f *fakeAWS) forward(service string, r *http.Request, path string, body []byte) (*fakeAWSResponse, error) {
+	resolved, err := f.resolve(service)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(resolved.URL)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawQuery = r.URL.RawQuery
+
+	req, err := http.NewRequest(r.Method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range r.Header {
+		switch http.CanonicalHeaderKey(k) {
+		case "Authorization", "Content-Length", "X-Amz-Content-Sha256", "X-Amz-Date", "X-Amz-Security-Token":
+			continue
+		}
+		req.Header[k] = v
+	}
+
+	signingName := resolved.SigningName
+	if signingName == "" {
+		signingName = service
+	}
+	signer := v4.NewSigner(f.credentials, func(s *v4.Signer) {
+		s.DisableURIPathEscaping = signingName == "s3"
+	})
+	if _, err := signer.Sign(req, bytes.NewReader(body), signingName, resolved.SigningRegion, time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := cleanhttp.DefaultClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string)
+	for k := range resp.Header {
+		switch strings.ToLower(k) {
+		case "connection", "content-length", "date", "server", "x-amz-id-2", "x-amz-request-id", "x-amzn-requestid":
+			continue
+		}
+		headers[k] = resp.Header.Get(k)
+	}
+
+	return &fakeAWSResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    headers,
+		Body:       string(respBody),
+	}, nil
+}
+
+// fakeAWSOperation identifies the operation of a request: the Action of query
+// protocol services, the X-Amz-Target of JSON protocol services, or else the
+// method, path and query parameter names of REST protocol services.
+func fakeAWSOperation(r *http.Request, path string, body []byte) string {
+	if target := r.Header.Get("X-Amz-Target"); target != "" {
+		return target
+	}
+
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if v, err := url.ParseQuery(string(body)); err == nil && v.Get("Action") != "" {
+			return v.Get("Action")
+		}
+	}
+
+	operation := r.Method + " " + path
+	if query := r.URL.Query(); len(query) > 0 {
+		names := make([]string, 0, len(query))
+		for name := range query {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		operation += "?" + strings.Join(names, "&")
+	}
+	return operation
+}
+
+// fakeAWSResourceStep is one configuration applied to a resource by
+// testFakeAWSResource.
+type fakeAWSResourceStep struct {
+	Config map[string]interface{}
+
+	// Check holds attribute values expected in the refreshed state.
+	Check map[string]string
+}
+
+// testFakeAWSResource runs a resource through its lifecycle against a fake AWS
+// server, the way Terraform would: each step is diffed, applied and refreshed,
+// and must then leave an empty plan. The resource is destroyed at the end.
+func testFakeAWSResource(t *testing.T, f *fakeAWS, resourceType string, steps ...fakeAWSResourceStep) {
+	r, ok := Provider().(*schema.Provider).ResourcesMap[resourceType]
+	if !ok {
+		t.Fatalf("unknown resource type %q", resourceType)
+	}
+	meta := f.Client()
+
+	var state *terraform.InstanceState
+	for i, step := range steps {
+		raw, err := config.NewRawConfig(step.Config)
+		if err != nil {
+			t.Fatalf("step %d: error parsing config: %s", i, err)
+		}
+		c := terraform.NewResourceConfig(raw)
+
+		diff, err := r.Diff(state, c, meta)
+		if err != nil {
+			t.Fatalf("step %d: error planning %s: %s", i, resourceType, err)
+		}
+		if diff != nil {
+			state, err = r.Apply(state, diff, meta)
+			if err != nil {
+				t.Fatalf("step %d: error applying %s: %s", i, resourceType, err)
+			}
+		}
+
+		state, err = r.Refresh(state, meta)
+		if err != nil {
+			t.Fatalf("step %d: error refreshing %s: %s", i, resourceType, err)
+		}
+		if state == nil || state.ID == "" {
+			t.Fatalf("step %d: %s not found after apply", i, resourceType)
+		}
+
+		for k, expected := range step.Check {
+			if actual := state.Attributes[k]; actual != expected {
+				t.Errorf("step %d: %s: expected %q, got %q", i, k, expected, actual)
+			}
+		}
+
+		diff, err = r.Diff(state, c, meta)
+		if err != nil {
+			t.Fatalf("step %d: error planning %s after apply: %s", i, resourceType, err)
+		}
+		if !diff.Empty() {
+			t.Fatalf("step %d: plan not empty after apply: %#v", i, diff)
+		}
+	}
+
+	if state != nil {
+		if _, err := r.Apply(state, &terraform.InstanceDiff{Destroy: true}, meta); err != nil {
+			t.Fatalf("error destroying %s: %s", resourceType, err)
+		}
+	}
+}
+
+func TestFakeAWS_recordReplay(t *testing.T) {
+	var authorization string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "text/xml")
+		switch r.FormValue("Action") {
+		case "GetCallerIdentity":
+			w.Write([]byte(stsResponse_GetCallerIdentity_valid))
+		case "ListQueues":
+			w.Write([]byte(fakeAWSResponse_ListQueues))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer upstream.Close()
+
+	dir, err := ioutil.TempDir("", "tf-fake-aws")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fixture := filepath.Join(dir, "fixture.json")
+
+	recorder := startFakeAWS(t, fixture, true)
+	recorder.credentials = credentials.NewStaticCredentials("AKIDRECORD", "secret", "")
+	recorder.resolve = func(service string) (endpoints.ResolvedEndpoint, error) {
+		return endpoints.ResolvedEndpoint{
+			URL:           upstream.URL,
+			SigningName:   service,
+			SigningRegion: fakeAWSRegion,
+		}, nil
+	}
+
+	if _, err := recorder.Client().sqsconn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("error recording: %s", err)
+	}
+	recorder.Close()
+
+	if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDRECORD/") || !strings.Contains(authorization, "/"+fakeAWSRegion+"/sqs/") {
+		t.Fatalf("forwarded request not re-signed: %q", authorization)
+	}
+
+	replayer := startFakeAWS(t, fixture, false)
+	defer replayer.Close()
+
+	if len(replayer.interactions) != 2 {
+		t.Fatalf("expected 2 recorded interactions, got %d", len(replayer.interactions))
+	}
+	if v := replayer.interactions[0]; v.Service != "sts" || v.Operation != "GetCallerIdentity" {
+		t.Fatalf("bad recorded interaction: %s %s", v.Service, v.Operation)
+	}
+	if v := replayer.interactions[1]; v.Service != "sqs" || v.Operation != "ListQueues" {
+		t.Fatalf("bad recorded interaction: %s %s", v.Service, v.Operation)
+	}
+
+	out, err := replayer.Client().sqsconn.ListQueues(&sqs.ListQueuesInput{})
+	if err != nil {
+		t.Fatalf("error replaying: %s", err)
+	}
+	if len(out.QueueUrls) != 1 || *out.QueueUrls[0] != "https://sqs.us-west-2.amazonaws.com/123456789012/tf-fake" {
+		t.Fatalf("bad replayed response: %s", out)
+	}
+}
+
+func TestFakeAWSOperation(t *testing.T) {
+	cases := []struct {
+		Method, URL, ContentType, Target, Body string
+		Expected                               string
+	}{
+		{
+			Method:      "POST",
+			URL:         "/ec2/",
+			ContentType: "application/x-www-form-urlencoded; charset=utf-8",
+			Body:        "Action=CreateVpc&CidrBlock=10.1.0.0%2F16&Version=2016-11-15",
+			Expected:    "CreateVpc",
+		},
+		{
+			Method:      "POST",
+			URL:         "/dynamodb/",
+			ContentType: "application/x-amz-json-1.0",
+			Target:      "DynamoDB_20120810.DescribeTable",
+			Body:        "{}",
+			Expected:    "DynamoDB_20120810.DescribeTable",
+		},
+		{
+			Method:   "GET",
+			URL:      "/s3/tf-fake-bucket?tagging=",
+			Expected: "GET /tf-fake-bucket?tagging",
+		},
+		{
+			Method:   "GET",
+			URL:      "/s3/tf-fake-bucket?prefix=a&list-type=2",
+			Expected: "GET /tf-fake-bucket?list-type&prefix",
+		},
+		{
+			Method:   "PUT",
+			URL:      "/s3/tf-fake-bucket",
+			Expected: "PUT /tf-fake-bucket",
+		},
+	}
+
+	for i, tc := range cases {
+		r := httptest.NewRequest(tc.Method, tc.URL, strings.NewReader(tc.Body))
+		if tc.ContentType != "" {
+			r.Header.Set("Content-Type", tc.ContentType)
+		}
+		if tc.Target != "" {
+			r.Header.Set("X-Amz-Target", tc.Target)
+		}
+		path := "/" + strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)[1]
+
+		if actual := fakeAWSOperation(r, path, []byte(tc.Body)); actual != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, actual)
+		}
+	}
+}
+
+const fakeAWSResponse_ListQueues = `<ListQueuesResponse xmlns="http://queue.amazonaws.com/doc/2012-11-05/">
+  <ListQueuesResult>
+    <QueueUrl>https://sqs.us-west-2.amazonaws.com/123456789012/tf-fake</QueueUrl>
+  </ListQueuesResult>
+  <ResponseMetadata>
+    <RequestId>725275ae-0b9b-4762-b238-436d7c65a1ac</RequestId>
+  </ResponseMetadata>
+</ListQueuesResponse>`
@@ -0,0 +1,262 @@
+package aws
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// tlsVersions maps the values accepted by the provider tls_min_version
+// argument to their TLS protocol versions.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// tlsVersionNames returns the values accepted by the provider
+// tls_min_version argument.
+func tlsVersionNames() []string {
+	return []string{"1.0", "1.1", "1.2", "1.3"}
+}
+
+// newHTTPClient returns an isolated HTTP client with the proxy and TLS
+// settings of the provider. Every service client, STS client and metadata
+// client is built on one of these so that all of them reach AWS the same way.
+func (c *Config) newHTTPClient() (*http.Client, error) {
+	proxy, err := c.proxyFunc()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := c.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client := cleanhttp.DefaultClient()
+	transport := client.Transport.(*http.Transport)
+	transport.Proxy = proxy
+	transport.TLSClientConfig = tlsConfig
+
+	return client, nil
+}
+
+// tlsConfig returns the TLS settings of the provider, or nil to use the
+// defaults.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	if !c.Insecure && c.CustomCABundle == "" && c.TLSMinVersion == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: c.Insecure,
+	}
+
+	if c.TLSMinVersion != "" {
+		version, ok := tlsVersions[c.TLSMinVersion]
+		if !ok {
+			return nil, fmt.Errorf("tls_min_version: unsupported TLS version %q, expected one of %s",
+				c.TLSMinVersion, strings.Join(tlsVersionNames(), ", "))
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if c.CustomCABundle != "" {
+		pem, err := ioutil.ReadFile(c.CustomCABundle)
+		if err != nil {
+			return nil, fmt.Errorf("custom_ca_bundle: error reading %s: %s", c.CustomCABundle, err)
+		}
+
+		// The bundle replaces the system roots, as with the AWS_CA_BUNDLE
+		// setting of the AWS CLI and SDKs.
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("custom_ca_bundle: no PEM encoded certificates found in %s", c.CustomCABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// proxyFunc returns the function selecting the proxy of each request. Each
+// of the provider http_proxy, https_proxy and no_proxy arguments falls back
+// to the usual environment variables when it is not set.
+func (c *Config) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	httpProxy, err := parseProxyURL("http_proxy", firstNonEmpty(c.HTTPProxy, os.Getenv("HTTP_PROXY"), os.Getenv("http_proxy")))
+	if err != nil {
+		return nil, err
+	}
+
+	httpsProxy, err := parseProxyURL("https_proxy", firstNonEmpty(c.HTTPSProxy, os.Getenv("HTTPS_PROXY"), os.Getenv("https_proxy")))
+	if err != nil {
+		return nil, err
+	}
+
+	noProxy := parseNoProxy(firstNonEmpty(c.NoProxy, os.Getenv("NO_PROXY"), os.Getenv("no_proxy")))
+
+	return func(r *http.Request) (*url.URL, error) {
+		if noProxy.matches(r.URL.Host) {
+			return nil, nil
+		}
+		if r.URL.Scheme == "https" {
+			return httpsProxy, nil
+		}
+		return httpProxy, nil
+	}, nil
+}
+
+// parseProxyURL parses the proxy URL set in the named argument. A URL without
+// a scheme, such as proxy.example.com:3128, is taken to be an HTTP proxy.
+func parseProxyURL(name, s string) (*url.URL, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	if !strings.Contains(s, "://") {
+		s = "http://" + s
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid proxy URL: %s", name, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("%s: invalid proxy URL %q: missing host", name, s)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("%s: invalid proxy URL %q: unsupported scheme %q", name, s, u.Scheme)
+	}
+
+	return u, nil
+}
+
+// noProxyRules holds the hosts that are reached directly rather than through
+// a proxy.
+type noProxyRules struct {
+	all      bool
+	networks []*net.IPNet
+	hosts    []noProxyHost
+}
+
+type noProxyHost struct {
+	// domain matches all of its subdomains and, when matchHost is set, the
+	// host itself.
+	domain    string
+	matchHost bool
+	port      string
+}
+
+// parseNoProxy parses a comma separated no_proxy list. Each entry is either
+// "*", an IP address or CIDR block, or a host name optionally followed by a
+// port. A host name matches its subdomains too, and one with a leading dot
+// matches only its subdomains.
+func parseNoProxy(s string) *noProxyRules {
+	rules := &noProxyRules{}
+
+	for _, entry := range strings.Split(s, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+
+		if entry == "*" {
+			rules.all = true
+			continue
+		}
+
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			rules.networks = append(rules.networks, network)
+			continue
+		}
+
+		host, port := entry, ""
+		if h, p, err := net.SplitHostPort(entry); err == nil {
+			host, port = h, p
+		}
+
+		if ip := net.ParseIP(host); ip != nil {
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			rules.networks = append(rules.networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		h := noProxyHost{domain: host, port: port}
+		if strings.HasPrefix(host, "*.") {
+			h.domain = host[2:]
+		} else if strings.HasPrefix(host, ".") {
+			h.domain = host[1:]
+		} else {
+			h.matchHost = true
+		}
+		rules.hosts = append(rules.hosts, h)
+	}
+
+	return rules
+}
+
+// matches returns whether the host, with an optional port, is to be reached
+// directly. As with http.ProxyFromEnvironment, localhost and loopback
+// addresses, such as those of LocalStack or of endpoints overrides, are
+// always reached directly.
+func (rules *noProxyRules) matches(hostport string) bool {
+	if rules.all {
+		return true
+	}
+
+	host, port := strings.ToLower(hostport), ""
+	if h, p, err := net.SplitHostPort(host); err == nil {
+		host, port = h, p
+	}
+
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return true
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.IsLoopback() {
+			return true
+		}
+		for _, network := range rules.networks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, h := range rules.hosts {
+		if h.port != "" && h.port != port {
+			continue
+		}
+		if strings.HasSuffix(host, "."+h.domain) || (h.matchHost && host == h.domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
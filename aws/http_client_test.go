@@ -0,0 +1,476 @@
+package aws
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	awsCredentials "github.com/aws/aws-sdk-go/aws/credentials"
+)
+
+func TestConfigNewHTTPClient_customCABundle(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer ts.Close()
+
+	// The test server certificate is not trusted by the system
+	client, err := (&Config{}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	if _, err := client.Get(ts.URL); err == nil {
+		t.Fatal("Expected an error without the custom CA bundle")
+	}
+
+	caBundle, removeCABundle := writeCABundle(t, ts)
+	defer removeCABundle()
+
+	client, err = (&Config{CustomCABundle: caBundle}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	if body := httpGet(t, client, ts.URL); body != "ok" {
+		t.Fatalf("Expected response %q, got %q", "ok", body)
+	}
+}
+
+func TestConfigNewHTTPClient_tlsMinVersion(t *testing.T) {
+	ts := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	ts.TLS = &tls.Config{MaxVersion: tls.VersionTLS12}
+	ts.StartTLS()
+	defer ts.Close()
+
+	caBundle, removeCABundle := writeCABundle(t, ts)
+	defer removeCABundle()
+
+	client, err := (&Config{CustomCABundle: caBundle, TLSMinVersion: "1.2"}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	if body := httpGet(t, client, ts.URL); body != "ok" {
+		t.Fatalf("Expected response %q, got %q", "ok", body)
+	}
+
+	client, err = (&Config{CustomCABundle: caBundle, TLSMinVersion: "1.3"}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	if _, err := client.Get(ts.URL); err == nil {
+		t.Fatal("Expected an error connecting to a TLS 1.2 server with a TLS 1.3 minimum")
+	}
+}
+
+func TestConfigNewHTTPClient_insecure(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer ts.Close()
+
+	client, err := (&Config{Insecure: true}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	if body := httpGet(t, client, ts.URL); body != "ok" {
+		t.Fatalf("Expected response %q, got %q", "ok", body)
+	}
+}
+
+func TestConfigNewHTTPClient_httpProxy(t *testing.T) {
+	resetEnv := unsetProxyEnv()
+	defer resetEnv()
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "direct")
+	}))
+	defer ts.Close()
+
+	proxy := newTestProxy(t)
+	defer proxy.Close()
+
+	client, err := (&Config{HTTPProxy: proxy.URL}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+
+	if body := httpGet(t, client, testProxyURL(ts.URL)+"/path"); body != "direct" {
+		t.Fatalf("Expected response %q, got %q", "direct", body)
+	}
+	if requests := proxy.Requests(); len(requests) != 1 || requests[0] != "GET "+testProxyURL(ts.URL)+"/path" {
+		t.Fatalf("Expected the request to go through the proxy, got: %q", requests)
+	}
+
+	// Loopback addresses are reached directly
+	if body := httpGet(t, client, ts.URL); body != "direct" {
+		t.Fatalf("Expected response %q, got %q", "direct", body)
+	}
+	if requests := proxy.Requests(); len(requests) != 1 {
+		t.Fatalf("Expected no further requests through the proxy, got: %q", requests)
+	}
+
+	// Hosts in no_proxy are reached directly
+	client, err = (&Config{HTTPProxy: proxy.URL, NoProxy: "example.com"}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	req, _ := http.NewRequest("GET", testProxyURL(ts.URL), nil)
+	if proxyURL, err := client.Transport.(*http.Transport).Proxy(req); err != nil || proxyURL != nil {
+		t.Fatalf("Expected no proxy for a host in no_proxy, got %s (%v)", proxyURL, err)
+	}
+}
+
+func TestConfigNewHTTPClient_httpsProxy(t *testing.T) {
+	resetEnv := unsetProxyEnv()
+	defer resetEnv()
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "ok")
+	}))
+	defer ts.Close()
+
+	caBundle, removeCABundle := writeCABundle(t, ts)
+	defer removeCABundle()
+
+	proxy := newTestProxy(t)
+	defer proxy.Close()
+
+	// The http_proxy is not used for HTTPS requests
+	client, err := (&Config{HTTPProxy: proxy.URL, CustomCABundle: caBundle}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	req, _ := http.NewRequest("GET", testProxyURL(ts.URL), nil)
+	if proxyURL, err := client.Transport.(*http.Transport).Proxy(req); err != nil || proxyURL != nil {
+		t.Fatalf("Expected no proxy for an HTTPS request, got %s (%v)", proxyURL, err)
+	}
+
+	client, err = (&Config{HTTPSProxy: strings.TrimPrefix(proxy.URL, "http://"), CustomCABundle: caBundle}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	if body := httpGet(t, client, testProxyURL(ts.URL)); body != "ok" {
+		t.Fatalf("Expected response %q, got %q", "ok", body)
+	}
+	host := strings.TrimPrefix(testProxyURL(ts.URL), "https://")
+	if requests := proxy.Requests(); len(requests) != 1 || requests[0] != "CONNECT "+host {
+		t.Fatalf("Expected the request to be tunnelled through the proxy, got: %q", requests)
+	}
+}
+
+func TestConfigNewHTTPClient_proxyEnv(t *testing.T) {
+	resetEnv := unsetProxyEnv()
+	defer resetEnv()
+
+	proxy := newTestProxy(t)
+	defer proxy.Close()
+
+	os.Setenv("HTTP_PROXY", proxy.URL)
+
+	client, err := (&Config{}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+
+	req, _ := http.NewRequest("GET", "http://ec2.us-east-1.amazonaws.com/", nil)
+	proxyURL, err := client.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Error selecting proxy: %s", err)
+	}
+	if proxyURL == nil || proxyURL.String() != proxy.URL {
+		t.Fatalf("Expected proxy %s from the environment, got %s", proxy.URL, proxyURL)
+	}
+
+	// Local endpoints, such as LocalStack, are reached directly
+	for _, u := range []string{"http://127.0.0.1:4566", "http://localhost", "http://localhost:4566/"} {
+		req, _ := http.NewRequest("GET", u, nil)
+		proxyURL, err := client.Transport.(*http.Transport).Proxy(req)
+		if err != nil {
+			t.Fatalf("Error selecting proxy: %s", err)
+		}
+		if proxyURL != nil {
+			t.Fatalf("Expected no proxy for %s, got %s", u, proxyURL)
+		}
+	}
+
+	// The provider setting takes precedence over the environment
+	client, err = (&Config{NoProxy: "amazonaws.com"}).newHTTPClient()
+	if err != nil {
+		t.Fatalf("Error creating HTTP client: %s", err)
+	}
+	proxyURL, err = client.Transport.(*http.Transport).Proxy(req)
+	if err != nil {
+		t.Fatalf("Error selecting proxy: %s", err)
+	}
+	if proxyURL != nil {
+		t.Fatalf("Expected no proxy, got %s", proxyURL)
+	}
+}
+
+func TestConfigNewHTTPClient_errors(t *testing.T) {
+	invalidBundle, err := ioutil.TempFile("", "tf-ca-bundle")
+	if err != nil {
+		t.Fatalf("Error creating temp file: %s", err)
+	}
+	defer os.Remove(invalidBundle.Name())
+	invalidBundle.WriteString("not a certificate")
+	invalidBundle.Close()
+
+	cases := []struct {
+		Config   *Config
+		ErrorMsg string
+	}{
+		{
+			Config:   &Config{CustomCABundle: "/nonexistent/ca.pem"},
+			ErrorMsg: "custom_ca_bundle: error reading /nonexistent/ca.pem",
+		},
+		{
+			Config:   &Config{CustomCABundle: invalidBundle.Name()},
+			ErrorMsg: "custom_ca_bundle: no PEM encoded certificates found",
+		},
+		{
+			Config:   &Config{TLSMinVersion: "1.4"},
+			ErrorMsg: `tls_min_version: unsupported TLS version "1.4"`,
+		},
+		{
+			Config:   &Config{HTTPProxy: "ftp://proxy.example.com"},
+			ErrorMsg: `http_proxy: invalid proxy URL "ftp://proxy.example.com": unsupported scheme "ftp"`,
+		},
+		{
+			Config:   &Config{HTTPSProxy: "http://"},
+			ErrorMsg: `https_proxy: invalid proxy URL "http://": missing host`,
+		},
+	}
+
+	for i, tc := range cases {
+		_, err := tc.Config.newHTTPClient()
+		if err == nil {
+			t.Fatalf("%d: expected an error", i)
+		}
+		if !strings.Contains(err.Error(), tc.ErrorMsg) {
+			t.Fatalf("%d: expected error containing %q, got: %s", i, tc.ErrorMsg, err)
+		}
+	}
+}
+
+func TestGetCredentials_customCABundleError(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	cfg := Config{
+		AccessKey:            "MockAccessKey",
+		SecretKey:            "MockSecretKey",
+		CustomCABundle:       "/nonexistent/ca.pem",
+		SkipMetadataApiCheck: true,
+	}
+
+	_, err := GetCredentials(&cfg)
+	if err == nil {
+		t.Fatal("Expected an error with a missing custom CA bundle")
+	}
+	if !strings.Contains(err.Error(), "/nonexistent/ca.pem") {
+		t.Fatalf("Expected error to name the CA bundle, got: %s", err)
+	}
+
+	if _, err := newCredentialsStsClient(&cfg, awsCredentials.AnonymousCredentials); err == nil {
+		t.Fatal("Expected an error creating an STS client with a missing custom CA bundle")
+	}
+}
+
+func TestNoProxyRules_matches(t *testing.T) {
+	cases := []struct {
+		NoProxy string
+		Host    string
+		Matches bool
+	}{
+		{"", "ec2.us-east-1.amazonaws.com", false},
+		{"*", "ec2.us-east-1.amazonaws.com", true},
+		{"amazonaws.com", "ec2.us-east-1.amazonaws.com", true},
+		{"amazonaws.com", "amazonaws.com", true},
+		{"amazonaws.com", "notamazonaws.com", false},
+		{".amazonaws.com", "ec2.us-east-1.amazonaws.com", true},
+		{".amazonaws.com", "amazonaws.com", false},
+		{"*.amazonaws.com", "s3.amazonaws.com", true},
+		{"example.com, AMAZONAWS.COM", "S3.amazonaws.com:443", true},
+		{"amazonaws.com:8443", "s3.amazonaws.com:443", false},
+		{"amazonaws.com:8443", "s3.amazonaws.com:8443", true},
+		{"169.254.169.254", "169.254.169.254", true},
+		{"169.254.169.254", "169.254.169.254:80", true},
+		{"169.254.169.254", "169.254.169.253", false},
+		{"10.0.0.0/8", "10.1.2.3:443", true},
+		{"10.0.0.0/8", "11.1.2.3", false},
+		{"::1", "[::1]:8080", true},
+		{"10.0.0.0/8", "ten.example.com", false},
+		{"", "127.0.0.1:4566", true},
+		{"", "127.0.0.2", true},
+		{"", "[::1]:4566", true},
+		{"", "localhost", true},
+		{"", "LocalHost:4566", true},
+		{"", "s3.localhost:4566", true},
+		{"", "localhost.example.com", false},
+	}
+
+	for _, tc := range cases {
+		if matches := parseNoProxy(tc.NoProxy).matches(tc.Host); matches != tc.Matches {
+			t.Fatalf("no_proxy %q, host %q: expected %t, got %t", tc.NoProxy, tc.Host, tc.Matches, matches)
+		}
+	}
+}
+
+// testProxy is a local HTTP proxy, forwarding plain requests and tunnelling
+// CONNECT requests, that records the requests made through it. Since
+// loopback addresses are never reached through a proxy, the test servers are
+// requested as example.com, which the proxy connects to on the loopback
+// address; their certificates are valid for both.
+type testProxy struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []string
+}
+
+func newTestProxy(t *testing.T) *testProxy {
+	p := &testProxy{}
+	p.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "CONNECT" {
+			p.record("CONNECT " + r.Host)
+			p.tunnel(t, w, r)
+			return
+		}
+
+		p.record(r.Method + " " + r.URL.String())
+		p.forward(t, w, r)
+	}))
+	return p
+}
+
+func (p *testProxy) record(request string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.requests = append(p.requests, request)
+}
+
+// Requests returns the requests made through the proxy so far.
+func (p *testProxy) Requests() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]string(nil), p.requests...)
+}
+
+func (p *testProxy) forward(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	req, err := http.NewRequest(r.Method, testProxyBackendURL(r.URL.String()), r.Body)
+	if err != nil {
+		t.Errorf("proxy: error creating request: %s", err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	resp, err := (&http.Transport{}).RoundTrip(req)
+	if err != nil {
+		t.Errorf("proxy: error forwarding request: %s", err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	w.WriteHeader(resp.StatusCode)
+	io.Copy(w, resp.Body)
+}
+
+func (p *testProxy) tunnel(t *testing.T, w http.ResponseWriter, r *http.Request) {
+	upstream, err := net.Dial("tcp", testProxyBackendURL(r.Host))
+	if err != nil {
+		t.Errorf("proxy: error connecting to %s: %s", r.Host, err)
+		w.WriteHeader(http.StatusBadGateway)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err != nil {
+		t.Errorf("proxy: error hijacking connection: %s", err)
+		upstream.Close()
+		return
+	}
+
+	go func() {
+		defer conn.Close()
+		defer upstream.Close()
+		io.Copy(upstream, conn)
+	}()
+	go io.Copy(conn, upstream)
+}
+
+// testProxyURL returns the URL of a test server as requested through the test
+// proxy.
+func testProxyURL(u string) string {
+	return strings.Replace(u, "127.0.0.1", "example.com", 1)
+}
+
+// testProxyBackendURL returns the URL of a test server as reached by the test
+// proxy.
+func testProxyBackendURL(u string) string {
+	return strings.Replace(u, "example.com", "127.0.0.1", 1)
+}
+
+// writeCABundle writes the certificate of the TLS test server to a PEM file,
+// returning its path and a function removing it.
+func writeCABundle(t *testing.T, ts *httptest.Server) (string, func()) {
+	f, err := ioutil.TempFile("", "tf-ca-bundle")
+	if err != nil {
+		t.Fatalf("Error creating temp file: %s", err)
+	}
+	defer f.Close()
+
+	if err := pem.Encode(f, &pem.Block{Type: "CERTIFICATE", Bytes: ts.Certificate().Raw}); err != nil {
+		t.Fatalf("Error writing CA bundle: %s", err)
+	}
+
+	return f.Name(), func() { os.Remove(f.Name()) }
+}
+
+func httpGet(t *testing.T, client *http.Client, u string) string {
+	resp, err := client.Get(u)
+	if err != nil {
+		t.Fatalf("Error requesting %s: %s", u, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading response: %s", err)
+	}
+	return string(body)
+}
+
+// unsetProxyEnv unsets the proxy environment variables, returning a function
+// restoring them.
+func unsetProxyEnv() func() {
+	names := []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy"}
+	values := make(map[string]string)
+	for _, name := range names {
+		if v, ok := os.LookupEnv(name); ok {
+			values[name] = v
+		}
+		os.Unsetenv(name)
+	}
+
+	return func() {
+		for _, name := range names {
+			os.Unsetenv(name)
+			if v, ok := values[name]; ok {
+				os.Setenv(name, v)
+			}
+		}
+	}
+}
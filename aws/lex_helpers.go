@@ -0,0 +1,245 @@
+package aws
+
+import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// lexVersionLatest is the version of Lex bots, intents and slot types which
+// is updated in place, as opposed to the numbered versions published from it.
+const lexVersionLatest = "$LATEST"
+
+// lexLatestVersion returns the highest of the numbered versions of a Lex bot,
+// intent or slot type, or $LATEST when none has been published.
+func lexLatestVersion(versions []string) string {
+	latest := 0
+	for _, version := range versions {
+		if n, err := strconv.Atoi(version); err == nil && n > latest {
+			latest = n
+		}
+	}
+
+	if latest == 0 {
+		return lexVersionLatest
+	}
+
+	return strconv.Itoa(latest)
+}
+
+func lexMessageSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 15,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+				"content_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						lexmodelbuildingservice.ContentTypeCustomPayload,
+						lexmodelbuildingservice.ContentTypePlainText,
+						lexmodelbuildingservice.ContentTypeSsml,
+					}, false),
+				},
+				"group_number": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 5),
+				},
+			},
+		},
+	}
+}
+
+// lexStatementSchema returns the schema of an optional statement, made of
+// messages sent to the user.
+func lexStatementSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message": lexMessageSchema(),
+				"response_card": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 50000),
+				},
+			},
+		},
+	}
+}
+
+// lexPromptSchema returns the schema of an optional prompt, a statement which
+// asks the user for information up to a number of attempts.
+func lexPromptSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 5),
+				},
+				"message": lexMessageSchema(),
+				"response_card": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 50000),
+				},
+			},
+		},
+	}
+}
+
+func lexCodeHookSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message_version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 5),
+				},
+				"uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+			},
+		},
+	}
+}
+
+func expandLexMessages(s *schema.Set) []*lexmodelbuildingservice.Message {
+	messages := make([]*lexmodelbuildingservice.Message, 0, s.Len())
+	for _, v := range s.List() {
+		m := v.(map[string]interface{})
+		message := &lexmodelbuildingservice.Message{
+			Content:     aws.String(m["content"].(string)),
+			ContentType: aws.String(m["content_type"].(string)),
+		}
+		if v, ok := m["group_number"].(int); ok && v != 0 {
+			message.GroupNumber = aws.Int64(int64(v))
+		}
+		messages = append(messages, message)
+	}
+
+	return messages
+}
+
+func flattenLexMessages(messages []*lexmodelbuildingservice.Message) []interface{} {
+	result := make([]interface{}, 0, len(messages))
+	for _, message := range messages {
+		result = append(result, map[string]interface{}{
+			"content":      aws.StringValue(message.Content),
+			"content_type": aws.StringValue(message.ContentType),
+			"group_number": int(aws.Int64Value(message.GroupNumber)),
+		})
+	}
+
+	return result
+}
+
+func expandLexStatement(l []interface{}) *lexmodelbuildingservice.Statement {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	statement := &lexmodelbuildingservice.Statement{
+		Messages: expandLexMessages(m["message"].(*schema.Set)),
+	}
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		statement.ResponseCard = aws.String(v)
+	}
+
+	return statement
+}
+
+func flattenLexStatement(statement *lexmodelbuildingservice.Statement) []interface{} {
+	if statement == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"message":       flattenLexMessages(statement.Messages),
+			"response_card": aws.StringValue(statement.ResponseCard),
+		},
+	}
+}
+
+func expandLexPrompt(l []interface{}) *lexmodelbuildingservice.Prompt {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	prompt := &lexmodelbuildingservice.Prompt{
+		MaxAttempts: aws.Int64(int64(m["max_attempts"].(int))),
+		Messages:    expandLexMessages(m["message"].(*schema.Set)),
+	}
+	if v, ok := m["response_card"].(string); ok && v != "" {
+		prompt.ResponseCard = aws.String(v)
+	}
+
+	return prompt
+}
+
+func flattenLexPrompt(prompt *lexmodelbuildingservice.Prompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"max_attempts":  int(aws.Int64Value(prompt.MaxAttempts)),
+			"message":       flattenLexMessages(prompt.Messages),
+			"response_card": aws.StringValue(prompt.ResponseCard),
+		},
+	}
+}
+
+func expandLexCodeHook(l []interface{}) *lexmodelbuildingservice.CodeHook {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	return &lexmodelbuildingservice.CodeHook{
+		MessageVersion: aws.String(m["message_version"].(string)),
+		Uri:            aws.String(m["uri"].(string)),
+	}
+}
+
+func flattenLexCodeHook(codeHook *lexmodelbuildingservice.CodeHook) []interface{} {
+	if codeHook == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"message_version": aws.StringValue(codeHook.MessageVersion),
+			"uri":             aws.StringValue(codeHook.Uri),
+		},
+	}
+}
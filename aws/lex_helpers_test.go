@@ -0,0 +1,35 @@
+package aws
+
+import (
+	"testing"
+)
+
+func TestLexLatestVersion(t *testing.T) {
+	cases := []struct {
+		Versions []string
+		Expected string
+	}{
+		{
+			Versions: []string{},
+			Expected: lexVersionLatest,
+		},
+		{
+			Versions: []string{lexVersionLatest},
+			Expected: lexVersionLatest,
+		},
+		{
+			Versions: []string{lexVersionLatest, "1"},
+			Expected: "1",
+		},
+		{
+			Versions: []string{"2", lexVersionLatest, "10", "9"},
+			Expected: "10",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := lexLatestVersion(tc.Versions); got != tc.Expected {
+			t.Errorf("lexLatestVersion(%v) = %q, expected %q", tc.Versions, got, tc.Expected)
+		}
+	}
+}
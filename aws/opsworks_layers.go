@@ -251,13 +251,38 @@ func (lt *opsworksLayerType) SchemaResource() *schema.Resource {
 			return lt.Delete(d, client)
 		},
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client := meta.(*AWSClient).opsworksconn
+				return lt.Import(d, client)
+			},
 		},
 
 		Schema: resourceSchema,
 	}
 }
 
+// Import checks that the layer being imported is of the type of the resource
+// it is imported as, since all layer types are described by the same API.
+func (lt *opsworksLayerType) Import(d *schema.ResourceData, client *opsworks.OpsWorks) ([]*schema.ResourceData, error) {
+	resp, err := client.DescribeLayers(&opsworks.DescribeLayersInput{
+		LayerIds: []*string{
+			aws.String(d.Id()),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading OpsWorks layer (%s): %s", d.Id(), err)
+	}
+	if len(resp.Layers) == 0 {
+		return nil, fmt.Errorf("OpsWorks layer (%s) not found", d.Id())
+	}
+
+	if layerType := aws.StringValue(resp.Layers[0].Type); layerType != lt.TypeName {
+		return nil, fmt.Errorf("OpsWorks layer (%s) is a %q layer, not a %q layer", d.Id(), layerType, lt.TypeName)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWorks) error {
 
 	req := &opsworks.DescribeLayersInput{
@@ -279,6 +304,12 @@ func (lt *opsworksLayerType) Read(d *schema.ResourceData, client *opsworks.OpsWo
 		return err
 	}
 
+	if len(resp.Layers) == 0 {
+		log.Printf("[WARN] OpsWorks layer (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	layer := resp.Layers[0]
 	d.SetId(aws.StringValue(layer.LayerId))
 	d.Set("auto_assign_elastic_ips", layer.AutoAssignElasticIps)
@@ -525,8 +556,6 @@ func (lt *opsworksLayerType) SetAttributeMap(d *schema.ResourceData, attrs map[s
 				// should never happen
 				panic(fmt.Errorf("Unsupported OpsWorks layer attribute type"))
 			}
-			return
-
 		} else {
 			d.Set(key, nil)
 		}
@@ -4,10 +4,14 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -18,7 +22,7 @@ func Provider() terraform.ResourceProvider {
 	// TODO: Move the configuration to this, requires validation
 
 	// The actual provider
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -43,6 +47,8 @@ func Provider() terraform.ResourceProvider {
 
 			"assume_role": assumeRoleSchema(),
 
+			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -50,6 +56,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["shared_credentials_file"],
 			},
 
+			"shared_config_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: descriptions["shared_config_file"],
+			},
+
 			"token": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -109,6 +122,8 @@ func Provider() terraform.ResourceProvider {
 
 			"endpoints": endpointsSchema(),
 
+			"rate_limit": rateLimitSchema(),
+
 			"insecure": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -116,6 +131,45 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["insecure"],
 			},
 
+			"http_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["http_proxy"],
+			},
+
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["https_proxy"],
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("AWS_CA_BUNDLE", ""),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"tls_min_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(tlsVersionNames(), false),
+				Description:  descriptions["tls_min_version"],
+			},
+
+			"api_trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TF_AWS_API_TRACE_FILE", ""),
+				Description: descriptions["api_trace_file"],
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -157,6 +211,10 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["s3_force_path_style"],
 			},
+
+			"default_tags": defaultTagsSchema(),
+
+			"ignore_tags": ignoreTagsSchema(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -207,6 +265,7 @@ func Provider() terraform.ResourceProvider {
 			"aws_elasticache_replication_group":    dataSourceAwsElasticacheReplicationGroup(),
 			"aws_elb_hosted_zone_id":               dataSourceAwsElbHostedZoneId(),
 			"aws_elb_service_account":              dataSourceAwsElbServiceAccount(),
+			"aws_fms_policy_compliance":            dataSourceAwsFmsPolicyCompliance(),
 			"aws_glue_script":                      dataSourceAwsGlueScript(),
 			"aws_iam_account_alias":                dataSourceAwsIamAccountAlias(),
 			"aws_iam_group":                        dataSourceAwsIAMGroup(),
@@ -232,6 +291,8 @@ func Provider() terraform.ResourceProvider {
 			"aws_lambda_invocation":                dataSourceAwsLambdaInvocation(),
 			"aws_launch_configuration":             dataSourceAwsLaunchConfiguration(),
 			"aws_launch_template":                  dataSourceAwsLaunchTemplate(),
+			"aws_lex_bot":                          dataSourceAwsLexBot(),
+			"aws_lex_intent":                       dataSourceAwsLexIntent(),
 			"aws_mq_broker":                        dataSourceAwsMqBroker(),
 			"aws_nat_gateway":                      dataSourceAwsNatGateway(),
 			"aws_network_acls":                     dataSourceAwsNetworkAcls(),
@@ -279,415 +340,428 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"aws_acm_certificate":                              resourceAwsAcmCertificate(),
-			"aws_acm_certificate_validation":                   resourceAwsAcmCertificateValidation(),
-			"aws_acmpca_certificate_authority":                 resourceAwsAcmpcaCertificateAuthority(),
-			"aws_ami":                                          resourceAwsAmi(),
-			"aws_ami_copy":                                     resourceAwsAmiCopy(),
-			"aws_ami_from_instance":                            resourceAwsAmiFromInstance(),
-			"aws_ami_launch_permission":                        resourceAwsAmiLaunchPermission(),
-			"aws_api_gateway_account":                          resourceAwsApiGatewayAccount(),
-			"aws_api_gateway_api_key":                          resourceAwsApiGatewayApiKey(),
-			"aws_api_gateway_authorizer":                       resourceAwsApiGatewayAuthorizer(),
-			"aws_api_gateway_base_path_mapping":                resourceAwsApiGatewayBasePathMapping(),
-			"aws_api_gateway_client_certificate":               resourceAwsApiGatewayClientCertificate(),
-			"aws_api_gateway_deployment":                       resourceAwsApiGatewayDeployment(),
-			"aws_api_gateway_documentation_part":               resourceAwsApiGatewayDocumentationPart(),
-			"aws_api_gateway_documentation_version":            resourceAwsApiGatewayDocumentationVersion(),
-			"aws_api_gateway_domain_name":                      resourceAwsApiGatewayDomainName(),
-			"aws_api_gateway_gateway_response":                 resourceAwsApiGatewayGatewayResponse(),
-			"aws_api_gateway_integration":                      resourceAwsApiGatewayIntegration(),
-			"aws_api_gateway_integration_response":             resourceAwsApiGatewayIntegrationResponse(),
-			"aws_api_gateway_method":                           resourceAwsApiGatewayMethod(),
-			"aws_api_gateway_method_response":                  resourceAwsApiGatewayMethodResponse(),
-			"aws_api_gateway_method_settings":                  resourceAwsApiGatewayMethodSettings(),
-			"aws_api_gateway_model":                            resourceAwsApiGatewayModel(),
-			"aws_api_gateway_request_validator":                resourceAwsApiGatewayRequestValidator(),
-			"aws_api_gateway_resource":                         resourceAwsApiGatewayResource(),
-			"aws_api_gateway_rest_api":                         resourceAwsApiGatewayRestApi(),
-			"aws_api_gateway_stage":                            resourceAwsApiGatewayStage(),
-			"aws_api_gateway_usage_plan":                       resourceAwsApiGatewayUsagePlan(),
-			"aws_api_gateway_usage_plan_key":                   resourceAwsApiGatewayUsagePlanKey(),
-			"aws_api_gateway_vpc_link":                         resourceAwsApiGatewayVpcLink(),
-			"aws_app_cookie_stickiness_policy":                 resourceAwsAppCookieStickinessPolicy(),
-			"aws_appautoscaling_target":                        resourceAwsAppautoscalingTarget(),
-			"aws_appautoscaling_policy":                        resourceAwsAppautoscalingPolicy(),
-			"aws_appautoscaling_scheduled_action":              resourceAwsAppautoscalingScheduledAction(),
-			"aws_appsync_api_key":                              resourceAwsAppsyncApiKey(),
-			"aws_appsync_datasource":                           resourceAwsAppsyncDatasource(),
-			"aws_appsync_graphql_api":                          resourceAwsAppsyncGraphqlApi(),
-			"aws_athena_database":                              resourceAwsAthenaDatabase(),
-			"aws_athena_named_query":                           resourceAwsAthenaNamedQuery(),
-			"aws_autoscaling_attachment":                       resourceAwsAutoscalingAttachment(),
-			"aws_autoscaling_group":                            resourceAwsAutoscalingGroup(),
-			"aws_autoscaling_lifecycle_hook":                   resourceAwsAutoscalingLifecycleHook(),
-			"aws_autoscaling_notification":                     resourceAwsAutoscalingNotification(),
-			"aws_autoscaling_policy":                           resourceAwsAutoscalingPolicy(),
-			"aws_autoscaling_schedule":                         resourceAwsAutoscalingSchedule(),
-			"aws_budgets_budget":                               resourceAwsBudgetsBudget(),
-			"aws_cloud9_environment_ec2":                       resourceAwsCloud9EnvironmentEc2(),
-			"aws_cloudformation_stack":                         resourceAwsCloudFormationStack(),
-			"aws_cloudfront_distribution":                      resourceAwsCloudFrontDistribution(),
-			"aws_cloudfront_origin_access_identity":            resourceAwsCloudFrontOriginAccessIdentity(),
-			"aws_cloudfront_public_key":                        resourceAwsCloudFrontPublicKey(),
-			"aws_cloudtrail":                                   resourceAwsCloudTrail(),
-			"aws_cloudwatch_event_permission":                  resourceAwsCloudWatchEventPermission(),
-			"aws_cloudwatch_event_rule":                        resourceAwsCloudWatchEventRule(),
-			"aws_cloudwatch_event_target":                      resourceAwsCloudWatchEventTarget(),
-			"aws_cloudwatch_log_destination":                   resourceAwsCloudWatchLogDestination(),
-			"aws_cloudwatch_log_destination_policy":            resourceAwsCloudWatchLogDestinationPolicy(),
-			"aws_cloudwatch_log_group":                         resourceAwsCloudWatchLogGroup(),
-			"aws_cloudwatch_log_metric_filter":                 resourceAwsCloudWatchLogMetricFilter(),
-			"aws_cloudwatch_log_resource_policy":               resourceAwsCloudWatchLogResourcePolicy(),
-			"aws_cloudwatch_log_stream":                        resourceAwsCloudWatchLogStream(),
-			"aws_cloudwatch_log_subscription_filter":           resourceAwsCloudwatchLogSubscriptionFilter(),
-			"aws_config_aggregate_authorization":               resourceAwsConfigAggregateAuthorization(),
-			"aws_config_config_rule":                           resourceAwsConfigConfigRule(),
-			"aws_config_configuration_aggregator":              resourceAwsConfigConfigurationAggregator(),
-			"aws_config_configuration_recorder":                resourceAwsConfigConfigurationRecorder(),
-			"aws_config_configuration_recorder_status":         resourceAwsConfigConfigurationRecorderStatus(),
-			"aws_config_delivery_channel":                      resourceAwsConfigDeliveryChannel(),
-			"aws_cognito_identity_pool":                        resourceAwsCognitoIdentityPool(),
-			"aws_cognito_identity_pool_roles_attachment":       resourceAwsCognitoIdentityPoolRolesAttachment(),
-			"aws_cognito_identity_provider":                    resourceAwsCognitoIdentityProvider(),
-			"aws_cognito_user_group":                           resourceAwsCognitoUserGroup(),
-			"aws_cognito_user_pool":                            resourceAwsCognitoUserPool(),
-			"aws_cognito_user_pool_client":                     resourceAwsCognitoUserPoolClient(),
-			"aws_cognito_user_pool_domain":                     resourceAwsCognitoUserPoolDomain(),
-			"aws_cloudhsm_v2_cluster":                          resourceAwsCloudHsm2Cluster(),
-			"aws_cloudhsm_v2_hsm":                              resourceAwsCloudHsm2Hsm(),
-			"aws_cognito_resource_server":                      resourceAwsCognitoResourceServer(),
-			"aws_cloudwatch_metric_alarm":                      resourceAwsCloudWatchMetricAlarm(),
-			"aws_cloudwatch_dashboard":                         resourceAwsCloudWatchDashboard(),
-			"aws_codedeploy_app":                               resourceAwsCodeDeployApp(),
-			"aws_codedeploy_deployment_config":                 resourceAwsCodeDeployDeploymentConfig(),
-			"aws_codedeploy_deployment_group":                  resourceAwsCodeDeployDeploymentGroup(),
-			"aws_codecommit_repository":                        resourceAwsCodeCommitRepository(),
-			"aws_codecommit_trigger":                           resourceAwsCodeCommitTrigger(),
-			"aws_codebuild_project":                            resourceAwsCodeBuildProject(),
-			"aws_codebuild_webhook":                            resourceAwsCodeBuildWebhook(),
-			"aws_codepipeline":                                 resourceAwsCodePipeline(),
-			"aws_codepipeline_webhook":                         resourceAwsCodePipelineWebhook(),
-			"aws_customer_gateway":                             resourceAwsCustomerGateway(),
-			"aws_dax_cluster":                                  resourceAwsDaxCluster(),
-			"aws_dax_parameter_group":                          resourceAwsDaxParameterGroup(),
-			"aws_dax_subnet_group":                             resourceAwsDaxSubnetGroup(),
-			"aws_db_cluster_snapshot":                          resourceAwsDbClusterSnapshot(),
-			"aws_db_event_subscription":                        resourceAwsDbEventSubscription(),
-			"aws_db_instance":                                  resourceAwsDbInstance(),
-			"aws_db_option_group":                              resourceAwsDbOptionGroup(),
-			"aws_db_parameter_group":                           resourceAwsDbParameterGroup(),
-			"aws_db_security_group":                            resourceAwsDbSecurityGroup(),
-			"aws_db_snapshot":                                  resourceAwsDbSnapshot(),
-			"aws_db_subnet_group":                              resourceAwsDbSubnetGroup(),
-			"aws_devicefarm_project":                           resourceAwsDevicefarmProject(),
-			"aws_directory_service_directory":                  resourceAwsDirectoryServiceDirectory(),
-			"aws_directory_service_conditional_forwarder":      resourceAwsDirectoryServiceConditionalForwarder(),
-			"aws_dms_certificate":                              resourceAwsDmsCertificate(),
-			"aws_dms_endpoint":                                 resourceAwsDmsEndpoint(),
-			"aws_dms_replication_instance":                     resourceAwsDmsReplicationInstance(),
-			"aws_dms_replication_subnet_group":                 resourceAwsDmsReplicationSubnetGroup(),
-			"aws_dms_replication_task":                         resourceAwsDmsReplicationTask(),
-			"aws_dx_bgp_peer":                                  resourceAwsDxBgpPeer(),
-			"aws_dx_connection":                                resourceAwsDxConnection(),
-			"aws_dx_connection_association":                    resourceAwsDxConnectionAssociation(),
-			"aws_dx_gateway":                                   resourceAwsDxGateway(),
-			"aws_dx_gateway_association":                       resourceAwsDxGatewayAssociation(),
-			"aws_dx_hosted_private_virtual_interface":          resourceAwsDxHostedPrivateVirtualInterface(),
-			"aws_dx_hosted_private_virtual_interface_accepter": resourceAwsDxHostedPrivateVirtualInterfaceAccepter(),
-			"aws_dx_hosted_public_virtual_interface":           resourceAwsDxHostedPublicVirtualInterface(),
-			"aws_dx_hosted_public_virtual_interface_accepter":  resourceAwsDxHostedPublicVirtualInterfaceAccepter(),
-			"aws_dx_lag":                                       resourceAwsDxLag(),
-			"aws_dx_private_virtual_interface":                 resourceAwsDxPrivateVirtualInterface(),
-			"aws_dx_public_virtual_interface":                  resourceAwsDxPublicVirtualInterface(),
-			"aws_dynamodb_table":                               resourceAwsDynamoDbTable(),
-			"aws_dynamodb_table_item":                          resourceAwsDynamoDbTableItem(),
-			"aws_dynamodb_global_table":                        resourceAwsDynamoDbGlobalTable(),
-			"aws_ec2_fleet":                                    resourceAwsEc2Fleet(),
-			"aws_ebs_snapshot":                                 resourceAwsEbsSnapshot(),
-			"aws_ebs_snapshot_copy":                            resourceAwsEbsSnapshotCopy(),
-			"aws_ebs_volume":                                   resourceAwsEbsVolume(),
-			"aws_ecr_lifecycle_policy":                         resourceAwsEcrLifecyclePolicy(),
-			"aws_ecr_repository":                               resourceAwsEcrRepository(),
-			"aws_ecr_repository_policy":                        resourceAwsEcrRepositoryPolicy(),
-			"aws_ecs_cluster":                                  resourceAwsEcsCluster(),
-			"aws_ecs_service":                                  resourceAwsEcsService(),
-			"aws_ecs_task_definition":                          resourceAwsEcsTaskDefinition(),
-			"aws_efs_file_system":                              resourceAwsEfsFileSystem(),
-			"aws_efs_mount_target":                             resourceAwsEfsMountTarget(),
-			"aws_egress_only_internet_gateway":                 resourceAwsEgressOnlyInternetGateway(),
-			"aws_eip":                                          resourceAwsEip(),
-			"aws_eip_association":                              resourceAwsEipAssociation(),
-			"aws_eks_cluster":                                  resourceAwsEksCluster(),
-			"aws_elasticache_cluster":                          resourceAwsElasticacheCluster(),
-			"aws_elasticache_parameter_group":                  resourceAwsElasticacheParameterGroup(),
-			"aws_elasticache_replication_group":                resourceAwsElasticacheReplicationGroup(),
-			"aws_elasticache_security_group":                   resourceAwsElasticacheSecurityGroup(),
-			"aws_elasticache_subnet_group":                     resourceAwsElasticacheSubnetGroup(),
-			"aws_elastic_beanstalk_application":                resourceAwsElasticBeanstalkApplication(),
-			"aws_elastic_beanstalk_application_version":        resourceAwsElasticBeanstalkApplicationVersion(),
-			"aws_elastic_beanstalk_configuration_template":     resourceAwsElasticBeanstalkConfigurationTemplate(),
-			"aws_elastic_beanstalk_environment":                resourceAwsElasticBeanstalkEnvironment(),
-			"aws_elasticsearch_domain":                         resourceAwsElasticSearchDomain(),
-			"aws_elasticsearch_domain_policy":                  resourceAwsElasticSearchDomainPolicy(),
-			"aws_elastictranscoder_pipeline":                   resourceAwsElasticTranscoderPipeline(),
-			"aws_elastictranscoder_preset":                     resourceAwsElasticTranscoderPreset(),
-			"aws_elb":                                          resourceAwsElb(),
-			"aws_elb_attachment":                               resourceAwsElbAttachment(),
-			"aws_emr_cluster":                                  resourceAwsEMRCluster(),
-			"aws_emr_instance_group":                           resourceAwsEMRInstanceGroup(),
-			"aws_emr_security_configuration":                   resourceAwsEMRSecurityConfiguration(),
-			"aws_flow_log":                                     resourceAwsFlowLog(),
-			"aws_gamelift_alias":                               resourceAwsGameliftAlias(),
-			"aws_gamelift_build":                               resourceAwsGameliftBuild(),
-			"aws_gamelift_fleet":                               resourceAwsGameliftFleet(),
-			"aws_glacier_vault":                                resourceAwsGlacierVault(),
-			"aws_glue_catalog_database":                        resourceAwsGlueCatalogDatabase(),
-			"aws_glue_catalog_table":                           resourceAwsGlueCatalogTable(),
-			"aws_glue_classifier":                              resourceAwsGlueClassifier(),
-			"aws_glue_connection":                              resourceAwsGlueConnection(),
-			"aws_glue_crawler":                                 resourceAwsGlueCrawler(),
-			"aws_glue_job":                                     resourceAwsGlueJob(),
-			"aws_glue_trigger":                                 resourceAwsGlueTrigger(),
-			"aws_guardduty_detector":                           resourceAwsGuardDutyDetector(),
-			"aws_guardduty_ipset":                              resourceAwsGuardDutyIpset(),
-			"aws_guardduty_member":                             resourceAwsGuardDutyMember(),
-			"aws_guardduty_threatintelset":                     resourceAwsGuardDutyThreatintelset(),
-			"aws_iam_access_key":                               resourceAwsIamAccessKey(),
-			"aws_iam_account_alias":                            resourceAwsIamAccountAlias(),
-			"aws_iam_account_password_policy":                  resourceAwsIamAccountPasswordPolicy(),
-			"aws_iam_group_policy":                             resourceAwsIamGroupPolicy(),
-			"aws_iam_group":                                    resourceAwsIamGroup(),
-			"aws_iam_group_membership":                         resourceAwsIamGroupMembership(),
-			"aws_iam_group_policy_attachment":                  resourceAwsIamGroupPolicyAttachment(),
-			"aws_iam_instance_profile":                         resourceAwsIamInstanceProfile(),
-			"aws_iam_openid_connect_provider":                  resourceAwsIamOpenIDConnectProvider(),
-			"aws_iam_policy":                                   resourceAwsIamPolicy(),
-			"aws_iam_policy_attachment":                        resourceAwsIamPolicyAttachment(),
-			"aws_iam_role_policy_attachment":                   resourceAwsIamRolePolicyAttachment(),
-			"aws_iam_role_policy":                              resourceAwsIamRolePolicy(),
-			"aws_iam_role":                                     resourceAwsIamRole(),
-			"aws_iam_saml_provider":                            resourceAwsIamSamlProvider(),
-			"aws_iam_server_certificate":                       resourceAwsIAMServerCertificate(),
-			"aws_iam_service_linked_role":                      resourceAwsIamServiceLinkedRole(),
-			"aws_iam_user_group_membership":                    resourceAwsIamUserGroupMembership(),
-			"aws_iam_user_policy_attachment":                   resourceAwsIamUserPolicyAttachment(),
-			"aws_iam_user_policy":                              resourceAwsIamUserPolicy(),
-			"aws_iam_user_ssh_key":                             resourceAwsIamUserSshKey(),
-			"aws_iam_user":                                     resourceAwsIamUser(),
-			"aws_iam_user_login_profile":                       resourceAwsIamUserLoginProfile(),
-			"aws_inspector_assessment_target":                  resourceAWSInspectorAssessmentTarget(),
-			"aws_inspector_assessment_template":                resourceAWSInspectorAssessmentTemplate(),
-			"aws_inspector_resource_group":                     resourceAWSInspectorResourceGroup(),
-			"aws_instance":                                     resourceAwsInstance(),
-			"aws_internet_gateway":                             resourceAwsInternetGateway(),
-			"aws_iot_certificate":                              resourceAwsIotCertificate(),
-			"aws_iot_policy":                                   resourceAwsIotPolicy(),
-			"aws_iot_thing":                                    resourceAwsIotThing(),
-			"aws_iot_thing_type":                               resourceAwsIotThingType(),
-			"aws_iot_topic_rule":                               resourceAwsIotTopicRule(),
-			"aws_key_pair":                                     resourceAwsKeyPair(),
-			"aws_kinesis_firehose_delivery_stream":             resourceAwsKinesisFirehoseDeliveryStream(),
-			"aws_kinesis_stream":                               resourceAwsKinesisStream(),
-			"aws_kms_alias":                                    resourceAwsKmsAlias(),
-			"aws_kms_grant":                                    resourceAwsKmsGrant(),
-			"aws_kms_key":                                      resourceAwsKmsKey(),
-			"aws_lambda_function":                              resourceAwsLambdaFunction(),
-			"aws_lambda_event_source_mapping":                  resourceAwsLambdaEventSourceMapping(),
-			"aws_lambda_alias":                                 resourceAwsLambdaAlias(),
-			"aws_lambda_permission":                            resourceAwsLambdaPermission(),
-			"aws_launch_configuration":                         resourceAwsLaunchConfiguration(),
-			"aws_launch_template":                              resourceAwsLaunchTemplate(),
-			"aws_lightsail_domain":                             resourceAwsLightsailDomain(),
-			"aws_lightsail_instance":                           resourceAwsLightsailInstance(),
-			"aws_lightsail_key_pair":                           resourceAwsLightsailKeyPair(),
-			"aws_lightsail_static_ip":                          resourceAwsLightsailStaticIp(),
-			"aws_lightsail_static_ip_attachment":               resourceAwsLightsailStaticIpAttachment(),
-			"aws_lb_cookie_stickiness_policy":                  resourceAwsLBCookieStickinessPolicy(),
-			"aws_load_balancer_policy":                         resourceAwsLoadBalancerPolicy(),
-			"aws_load_balancer_backend_server_policy":          resourceAwsLoadBalancerBackendServerPolicies(),
-			"aws_load_balancer_listener_policy":                resourceAwsLoadBalancerListenerPolicies(),
-			"aws_lb_ssl_negotiation_policy":                    resourceAwsLBSSLNegotiationPolicy(),
-			"aws_macie_member_account_association":             resourceAwsMacieMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":                  resourceAwsMacieS3BucketAssociation(),
-			"aws_main_route_table_association":                 resourceAwsMainRouteTableAssociation(),
-			"aws_mq_broker":                                    resourceAwsMqBroker(),
-			"aws_mq_configuration":                             resourceAwsMqConfiguration(),
-			"aws_media_store_container":                        resourceAwsMediaStoreContainer(),
-			"aws_media_store_container_policy":                 resourceAwsMediaStoreContainerPolicy(),
-			"aws_nat_gateway":                                  resourceAwsNatGateway(),
-			"aws_network_acl":                                  resourceAwsNetworkAcl(),
-			"aws_default_network_acl":                          resourceAwsDefaultNetworkAcl(),
-			"aws_neptune_cluster":                              resourceAwsNeptuneCluster(),
-			"aws_neptune_cluster_instance":                     resourceAwsNeptuneClusterInstance(),
-			"aws_neptune_cluster_parameter_group":              resourceAwsNeptuneClusterParameterGroup(),
-			"aws_neptune_cluster_snapshot":                     resourceAwsNeptuneClusterSnapshot(),
-			"aws_neptune_event_subscription":                   resourceAwsNeptuneEventSubscription(),
-			"aws_neptune_parameter_group":                      resourceAwsNeptuneParameterGroup(),
-			"aws_neptune_subnet_group":                         resourceAwsNeptuneSubnetGroup(),
-			"aws_network_acl_rule":                             resourceAwsNetworkAclRule(),
-			"aws_network_interface":                            resourceAwsNetworkInterface(),
-			"aws_network_interface_attachment":                 resourceAwsNetworkInterfaceAttachment(),
-			"aws_opsworks_application":                         resourceAwsOpsworksApplication(),
-			"aws_opsworks_stack":                               resourceAwsOpsworksStack(),
-			"aws_opsworks_java_app_layer":                      resourceAwsOpsworksJavaAppLayer(),
-			"aws_opsworks_haproxy_layer":                       resourceAwsOpsworksHaproxyLayer(),
-			"aws_opsworks_static_web_layer":                    resourceAwsOpsworksStaticWebLayer(),
-			"aws_opsworks_php_app_layer":                       resourceAwsOpsworksPhpAppLayer(),
-			"aws_opsworks_rails_app_layer":                     resourceAwsOpsworksRailsAppLayer(),
-			"aws_opsworks_nodejs_app_layer":                    resourceAwsOpsworksNodejsAppLayer(),
-			"aws_opsworks_memcached_layer":                     resourceAwsOpsworksMemcachedLayer(),
-			"aws_opsworks_mysql_layer":                         resourceAwsOpsworksMysqlLayer(),
-			"aws_opsworks_ganglia_layer":                       resourceAwsOpsworksGangliaLayer(),
-			"aws_opsworks_custom_layer":                        resourceAwsOpsworksCustomLayer(),
-			"aws_opsworks_instance":                            resourceAwsOpsworksInstance(),
-			"aws_opsworks_user_profile":                        resourceAwsOpsworksUserProfile(),
-			"aws_opsworks_permission":                          resourceAwsOpsworksPermission(),
-			"aws_opsworks_rds_db_instance":                     resourceAwsOpsworksRdsDbInstance(),
-			"aws_organizations_organization":                   resourceAwsOrganizationsOrganization(),
-			"aws_organizations_account":                        resourceAwsOrganizationsAccount(),
-			"aws_organizations_policy":                         resourceAwsOrganizationsPolicy(),
-			"aws_organizations_policy_attachment":              resourceAwsOrganizationsPolicyAttachment(),
-			"aws_placement_group":                              resourceAwsPlacementGroup(),
-			"aws_proxy_protocol_policy":                        resourceAwsProxyProtocolPolicy(),
-			"aws_rds_cluster":                                  resourceAwsRDSCluster(),
-			"aws_rds_cluster_instance":                         resourceAwsRDSClusterInstance(),
-			"aws_rds_cluster_parameter_group":                  resourceAwsRDSClusterParameterGroup(),
-			"aws_redshift_cluster":                             resourceAwsRedshiftCluster(),
-			"aws_redshift_security_group":                      resourceAwsRedshiftSecurityGroup(),
-			"aws_redshift_parameter_group":                     resourceAwsRedshiftParameterGroup(),
-			"aws_redshift_subnet_group":                        resourceAwsRedshiftSubnetGroup(),
-			"aws_redshift_snapshot_copy_grant":                 resourceAwsRedshiftSnapshotCopyGrant(),
-			"aws_redshift_event_subscription":                  resourceAwsRedshiftEventSubscription(),
-			"aws_route53_delegation_set":                       resourceAwsRoute53DelegationSet(),
-			"aws_route53_query_log":                            resourceAwsRoute53QueryLog(),
-			"aws_route53_record":                               resourceAwsRoute53Record(),
-			"aws_route53_zone_association":                     resourceAwsRoute53ZoneAssociation(),
-			"aws_route53_zone":                                 resourceAwsRoute53Zone(),
-			"aws_route53_health_check":                         resourceAwsRoute53HealthCheck(),
-			"aws_route":                                        resourceAwsRoute(),
-			"aws_route_table":                                  resourceAwsRouteTable(),
-			"aws_default_route_table":                          resourceAwsDefaultRouteTable(),
-			"aws_route_table_association":                      resourceAwsRouteTableAssociation(),
-			"aws_secretsmanager_secret":                        resourceAwsSecretsManagerSecret(),
-			"aws_secretsmanager_secret_version":                resourceAwsSecretsManagerSecretVersion(),
-			"aws_ses_active_receipt_rule_set":                  resourceAwsSesActiveReceiptRuleSet(),
-			"aws_ses_domain_identity":                          resourceAwsSesDomainIdentity(),
-			"aws_ses_domain_identity_verification":             resourceAwsSesDomainIdentityVerification(),
-			"aws_ses_domain_dkim":                              resourceAwsSesDomainDkim(),
-			"aws_ses_domain_mail_from":                         resourceAwsSesDomainMailFrom(),
-			"aws_ses_receipt_filter":                           resourceAwsSesReceiptFilter(),
-			"aws_ses_receipt_rule":                             resourceAwsSesReceiptRule(),
-			"aws_ses_receipt_rule_set":                         resourceAwsSesReceiptRuleSet(),
-			"aws_ses_configuration_set":                        resourceAwsSesConfigurationSet(),
-			"aws_ses_event_destination":                        resourceAwsSesEventDestination(),
-			"aws_ses_identity_notification_topic":              resourceAwsSesNotificationTopic(),
-			"aws_ses_template":                                 resourceAwsSesTemplate(),
-			"aws_s3_bucket":                                    resourceAwsS3Bucket(),
-			"aws_s3_bucket_policy":                             resourceAwsS3BucketPolicy(),
-			"aws_s3_bucket_object":                             resourceAwsS3BucketObject(),
-			"aws_s3_bucket_notification":                       resourceAwsS3BucketNotification(),
-			"aws_s3_bucket_metric":                             resourceAwsS3BucketMetric(),
-			"aws_s3_bucket_inventory":                          resourceAwsS3BucketInventory(),
-			"aws_security_group":                               resourceAwsSecurityGroup(),
-			"aws_network_interface_sg_attachment":              resourceAwsNetworkInterfaceSGAttachment(),
-			"aws_default_security_group":                       resourceAwsDefaultSecurityGroup(),
-			"aws_security_group_rule":                          resourceAwsSecurityGroupRule(),
-			"aws_servicecatalog_portfolio":                     resourceAwsServiceCatalogPortfolio(),
-			"aws_service_discovery_private_dns_namespace":      resourceAwsServiceDiscoveryPrivateDnsNamespace(),
-			"aws_service_discovery_public_dns_namespace":       resourceAwsServiceDiscoveryPublicDnsNamespace(),
-			"aws_service_discovery_service":                    resourceAwsServiceDiscoveryService(),
-			"aws_simpledb_domain":                              resourceAwsSimpleDBDomain(),
-			"aws_ssm_activation":                               resourceAwsSsmActivation(),
-			"aws_ssm_association":                              resourceAwsSsmAssociation(),
-			"aws_ssm_document":                                 resourceAwsSsmDocument(),
-			"aws_ssm_maintenance_window":                       resourceAwsSsmMaintenanceWindow(),
-			"aws_ssm_maintenance_window_target":                resourceAwsSsmMaintenanceWindowTarget(),
-			"aws_ssm_maintenance_window_task":                  resourceAwsSsmMaintenanceWindowTask(),
-			"aws_ssm_patch_baseline":                           resourceAwsSsmPatchBaseline(),
-			"aws_ssm_patch_group":                              resourceAwsSsmPatchGroup(),
-			"aws_ssm_parameter":                                resourceAwsSsmParameter(),
-			"aws_ssm_resource_data_sync":                       resourceAwsSsmResourceDataSync(),
-			"aws_storagegateway_cache":                         resourceAwsStorageGatewayCache(),
-			"aws_storagegateway_cached_iscsi_volume":           resourceAwsStorageGatewayCachedIscsiVolume(),
-			"aws_storagegateway_gateway":                       resourceAwsStorageGatewayGateway(),
-			"aws_storagegateway_nfs_file_share":                resourceAwsStorageGatewayNfsFileShare(),
-			"aws_storagegateway_smb_file_share":                resourceAwsStorageGatewaySmbFileShare(),
-			"aws_storagegateway_upload_buffer":                 resourceAwsStorageGatewayUploadBuffer(),
-			"aws_storagegateway_working_storage":               resourceAwsStorageGatewayWorkingStorage(),
-			"aws_spot_datafeed_subscription":                   resourceAwsSpotDataFeedSubscription(),
-			"aws_spot_instance_request":                        resourceAwsSpotInstanceRequest(),
-			"aws_spot_fleet_request":                           resourceAwsSpotFleetRequest(),
-			"aws_sqs_queue":                                    resourceAwsSqsQueue(),
-			"aws_sqs_queue_policy":                             resourceAwsSqsQueuePolicy(),
-			"aws_snapshot_create_volume_permission":            resourceAwsSnapshotCreateVolumePermission(),
-			"aws_sns_platform_application":                     resourceAwsSnsPlatformApplication(),
-			"aws_sns_sms_preferences":                          resourceAwsSnsSmsPreferences(),
-			"aws_sns_topic":                                    resourceAwsSnsTopic(),
-			"aws_sns_topic_policy":                             resourceAwsSnsTopicPolicy(),
-			"aws_sns_topic_subscription":                       resourceAwsSnsTopicSubscription(),
-			"aws_sfn_activity":                                 resourceAwsSfnActivity(),
-			"aws_sfn_state_machine":                            resourceAwsSfnStateMachine(),
-			"aws_default_subnet":                               resourceAwsDefaultSubnet(),
-			"aws_subnet":                                       resourceAwsSubnet(),
-			"aws_swf_domain":                                   resourceAwsSwfDomain(),
-			"aws_volume_attachment":                            resourceAwsVolumeAttachment(),
-			"aws_vpc_dhcp_options_association":                 resourceAwsVpcDhcpOptionsAssociation(),
-			"aws_default_vpc_dhcp_options":                     resourceAwsDefaultVpcDhcpOptions(),
-			"aws_vpc_dhcp_options":                             resourceAwsVpcDhcpOptions(),
-			"aws_vpc_peering_connection":                       resourceAwsVpcPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":              resourceAwsVpcPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":               resourceAwsVpcPeeringConnectionOptions(),
-			"aws_default_vpc":                                  resourceAwsDefaultVpc(),
-			"aws_vpc":                                          resourceAwsVpc(),
-			"aws_vpc_endpoint":                                 resourceAwsVpcEndpoint(),
-			"aws_vpc_endpoint_connection_notification":         resourceAwsVpcEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":         resourceAwsVpcEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_subnet_association":              resourceAwsVpcEndpointSubnetAssociation(),
-			"aws_vpc_endpoint_service":                         resourceAwsVpcEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":       resourceAwsVpcEndpointServiceAllowedPrincipal(),
-			"aws_vpc_ipv4_cidr_block_association":              resourceAwsVpcIpv4CidrBlockAssociation(),
-			"aws_vpn_connection":                               resourceAwsVpnConnection(),
-			"aws_vpn_connection_route":                         resourceAwsVpnConnectionRoute(),
-			"aws_vpn_gateway":                                  resourceAwsVpnGateway(),
-			"aws_vpn_gateway_attachment":                       resourceAwsVpnGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                resourceAwsVpnGatewayRoutePropagation(),
-			"aws_waf_byte_match_set":                           resourceAwsWafByteMatchSet(),
-			"aws_waf_ipset":                                    resourceAwsWafIPSet(),
-			"aws_waf_rate_based_rule":                          resourceAwsWafRateBasedRule(),
-			"aws_waf_regex_match_set":                          resourceAwsWafRegexMatchSet(),
-			"aws_waf_regex_pattern_set":                        resourceAwsWafRegexPatternSet(),
-			"aws_waf_rule":                                     resourceAwsWafRule(),
-			"aws_waf_rule_group":                               resourceAwsWafRuleGroup(),
-			"aws_waf_size_constraint_set":                      resourceAwsWafSizeConstraintSet(),
-			"aws_waf_web_acl":                                  resourceAwsWafWebAcl(),
-			"aws_waf_xss_match_set":                            resourceAwsWafXssMatchSet(),
-			"aws_waf_sql_injection_match_set":                  resourceAwsWafSqlInjectionMatchSet(),
-			"aws_waf_geo_match_set":                            resourceAwsWafGeoMatchSet(),
-			"aws_wafregional_byte_match_set":                   resourceAwsWafRegionalByteMatchSet(),
-			"aws_wafregional_geo_match_set":                    resourceAwsWafRegionalGeoMatchSet(),
-			"aws_wafregional_ipset":                            resourceAwsWafRegionalIPSet(),
-			"aws_wafregional_rate_based_rule":                  resourceAwsWafRegionalRateBasedRule(),
-			"aws_wafregional_regex_match_set":                  resourceAwsWafRegionalRegexMatchSet(),
-			"aws_wafregional_regex_pattern_set":                resourceAwsWafRegionalRegexPatternSet(),
-			"aws_wafregional_rule":                             resourceAwsWafRegionalRule(),
-			"aws_wafregional_rule_group":                       resourceAwsWafRegionalRuleGroup(),
-			"aws_wafregional_size_constraint_set":              resourceAwsWafRegionalSizeConstraintSet(),
-			"aws_wafregional_sql_injection_match_set":          resourceAwsWafRegionalSqlInjectionMatchSet(),
-			"aws_wafregional_xss_match_set":                    resourceAwsWafRegionalXssMatchSet(),
-			"aws_wafregional_web_acl":                          resourceAwsWafRegionalWebAcl(),
-			"aws_wafregional_web_acl_association":              resourceAwsWafRegionalWebAclAssociation(),
-			"aws_batch_compute_environment":                    resourceAwsBatchComputeEnvironment(),
-			"aws_batch_job_definition":                         resourceAwsBatchJobDefinition(),
-			"aws_batch_job_queue":                              resourceAwsBatchJobQueue(),
-			"aws_pinpoint_app":                                 resourceAwsPinpointApp(),
-			"aws_pinpoint_adm_channel":                         resourceAwsPinpointADMChannel(),
-			"aws_pinpoint_apns_channel":                        resourceAwsPinpointAPNSChannel(),
-			"aws_pinpoint_baidu_channel":                       resourceAwsPinpointBaiduChannel(),
-			"aws_pinpoint_email_channel":                       resourceAwsPinpointEmailChannel(),
-			"aws_pinpoint_event_stream":                        resourceAwsPinpointEventStream(),
-			"aws_pinpoint_gcm_channel":                         resourceAwsPinpointGCMChannel(),
-			"aws_pinpoint_sms_channel":                         resourceAwsPinpointSMSChannel(),
+			"aws_acm_certificate":                                     regionalResource(resourceAwsAcmCertificate()),
+			"aws_acm_certificate_validation":                          regionalResource(resourceAwsAcmCertificateValidation()),
+			"aws_acmpca_certificate_authority":                        resourceAwsAcmpcaCertificateAuthority(),
+			"aws_ami":                                                 resourceAwsAmi(),
+			"aws_ami_copy":                                            resourceAwsAmiCopy(),
+			"aws_ami_from_instance":                                   resourceAwsAmiFromInstance(),
+			"aws_ami_launch_permission":                               resourceAwsAmiLaunchPermission(),
+			"aws_api_gateway_account":                                 resourceAwsApiGatewayAccount(),
+			"aws_api_gateway_api_key":                                 resourceAwsApiGatewayApiKey(),
+			"aws_api_gateway_authorizer":                              resourceAwsApiGatewayAuthorizer(),
+			"aws_api_gateway_base_path_mapping":                       resourceAwsApiGatewayBasePathMapping(),
+			"aws_api_gateway_client_certificate":                      resourceAwsApiGatewayClientCertificate(),
+			"aws_api_gateway_deployment":                              resourceAwsApiGatewayDeployment(),
+			"aws_api_gateway_documentation_part":                      resourceAwsApiGatewayDocumentationPart(),
+			"aws_api_gateway_documentation_version":                   resourceAwsApiGatewayDocumentationVersion(),
+			"aws_api_gateway_domain_name":                             resourceAwsApiGatewayDomainName(),
+			"aws_api_gateway_gateway_response":                        resourceAwsApiGatewayGatewayResponse(),
+			"aws_api_gateway_integration":                             resourceAwsApiGatewayIntegration(),
+			"aws_api_gateway_integration_response":                    resourceAwsApiGatewayIntegrationResponse(),
+			"aws_api_gateway_method":                                  resourceAwsApiGatewayMethod(),
+			"aws_api_gateway_method_response":                         resourceAwsApiGatewayMethodResponse(),
+			"aws_api_gateway_method_settings":                         resourceAwsApiGatewayMethodSettings(),
+			"aws_api_gateway_model":                                   resourceAwsApiGatewayModel(),
+			"aws_api_gateway_request_validator":                       resourceAwsApiGatewayRequestValidator(),
+			"aws_api_gateway_resource":                                resourceAwsApiGatewayResource(),
+			"aws_api_gateway_rest_api":                                resourceAwsApiGatewayRestApi(),
+			"aws_api_gateway_stage":                                   resourceAwsApiGatewayStage(),
+			"aws_api_gateway_usage_plan":                              resourceAwsApiGatewayUsagePlan(),
+			"aws_api_gateway_usage_plan_key":                          resourceAwsApiGatewayUsagePlanKey(),
+			"aws_api_gateway_vpc_link":                                resourceAwsApiGatewayVpcLink(),
+			"aws_app_cookie_stickiness_policy":                        resourceAwsAppCookieStickinessPolicy(),
+			"aws_appautoscaling_target":                               resourceAwsAppautoscalingTarget(),
+			"aws_appautoscaling_policy":                               resourceAwsAppautoscalingPolicy(),
+			"aws_appautoscaling_scheduled_action":                     resourceAwsAppautoscalingScheduledAction(),
+			"aws_appsync_api_key":                                     resourceAwsAppsyncApiKey(),
+			"aws_appsync_datasource":                                  resourceAwsAppsyncDatasource(),
+			"aws_appsync_graphql_api":                                 resourceAwsAppsyncGraphqlApi(),
+			"aws_athena_database":                                     resourceAwsAthenaDatabase(),
+			"aws_athena_named_query":                                  resourceAwsAthenaNamedQuery(),
+			"aws_autoscaling_attachment":                              resourceAwsAutoscalingAttachment(),
+			"aws_autoscaling_group":                                   resourceAwsAutoscalingGroup(),
+			"aws_autoscaling_lifecycle_hook":                          resourceAwsAutoscalingLifecycleHook(),
+			"aws_autoscaling_notification":                            resourceAwsAutoscalingNotification(),
+			"aws_autoscaling_policy":                                  resourceAwsAutoscalingPolicy(),
+			"aws_autoscaling_schedule":                                resourceAwsAutoscalingSchedule(),
+			"aws_budgets_budget":                                      resourceAwsBudgetsBudget(),
+			"aws_cloud9_environment_ec2":                              resourceAwsCloud9EnvironmentEc2(),
+			"aws_cloudformation_stack":                                resourceAwsCloudFormationStack(),
+			"aws_cloudfront_distribution":                             resourceAwsCloudFrontDistribution(),
+			"aws_cloudfront_origin_access_identity":                   resourceAwsCloudFrontOriginAccessIdentity(),
+			"aws_cloudfront_public_key":                               resourceAwsCloudFrontPublicKey(),
+			"aws_cloudtrail":                                          resourceAwsCloudTrail(),
+			"aws_cloudwatch_event_permission":                         resourceAwsCloudWatchEventPermission(),
+			"aws_cloudwatch_event_rule":                               resourceAwsCloudWatchEventRule(),
+			"aws_cloudwatch_event_target":                             resourceAwsCloudWatchEventTarget(),
+			"aws_cloudwatch_log_destination":                          resourceAwsCloudWatchLogDestination(),
+			"aws_cloudwatch_log_destination_policy":                   resourceAwsCloudWatchLogDestinationPolicy(),
+			"aws_cloudwatch_log_group":                                resourceAwsCloudWatchLogGroup(),
+			"aws_cloudwatch_log_metric_filter":                        resourceAwsCloudWatchLogMetricFilter(),
+			"aws_cloudwatch_log_resource_policy":                      resourceAwsCloudWatchLogResourcePolicy(),
+			"aws_cloudwatch_log_stream":                               resourceAwsCloudWatchLogStream(),
+			"aws_cloudwatch_log_subscription_filter":                  resourceAwsCloudwatchLogSubscriptionFilter(),
+			"aws_config_aggregate_authorization":                      resourceAwsConfigAggregateAuthorization(),
+			"aws_config_config_rule":                                  resourceAwsConfigConfigRule(),
+			"aws_config_configuration_aggregator":                     resourceAwsConfigConfigurationAggregator(),
+			"aws_config_configuration_recorder":                       resourceAwsConfigConfigurationRecorder(),
+			"aws_config_configuration_recorder_status":                resourceAwsConfigConfigurationRecorderStatus(),
+			"aws_config_delivery_channel":                             resourceAwsConfigDeliveryChannel(),
+			"aws_cognito_identity_pool":                               resourceAwsCognitoIdentityPool(),
+			"aws_cognito_identity_pool_roles_attachment":              resourceAwsCognitoIdentityPoolRolesAttachment(),
+			"aws_cognito_identity_provider":                           resourceAwsCognitoIdentityProvider(),
+			"aws_cognito_user_group":                                  resourceAwsCognitoUserGroup(),
+			"aws_cognito_user_pool":                                   resourceAwsCognitoUserPool(),
+			"aws_cognito_user_pool_client":                            resourceAwsCognitoUserPoolClient(),
+			"aws_cognito_user_pool_domain":                            resourceAwsCognitoUserPoolDomain(),
+			"aws_cloudhsm_v2_cluster":                                 resourceAwsCloudHsm2Cluster(),
+			"aws_cloudhsm_v2_hsm":                                     resourceAwsCloudHsm2Hsm(),
+			"aws_cognito_resource_server":                             resourceAwsCognitoResourceServer(),
+			"aws_cloudwatch_metric_alarm":                             resourceAwsCloudWatchMetricAlarm(),
+			"aws_cloudwatch_dashboard":                                resourceAwsCloudWatchDashboard(),
+			"aws_codedeploy_app":                                      resourceAwsCodeDeployApp(),
+			"aws_codedeploy_deployment_config":                        resourceAwsCodeDeployDeploymentConfig(),
+			"aws_codedeploy_deployment_group":                         resourceAwsCodeDeployDeploymentGroup(),
+			"aws_codecommit_repository":                               resourceAwsCodeCommitRepository(),
+			"aws_codecommit_trigger":                                  resourceAwsCodeCommitTrigger(),
+			"aws_codebuild_project":                                   resourceAwsCodeBuildProject(),
+			"aws_codebuild_webhook":                                   resourceAwsCodeBuildWebhook(),
+			"aws_codepipeline":                                        resourceAwsCodePipeline(),
+			"aws_codepipeline_webhook":                                resourceAwsCodePipelineWebhook(),
+			"aws_customer_gateway":                                    resourceAwsCustomerGateway(),
+			"aws_dax_cluster":                                         resourceAwsDaxCluster(),
+			"aws_dax_parameter_group":                                 resourceAwsDaxParameterGroup(),
+			"aws_dax_subnet_group":                                    resourceAwsDaxSubnetGroup(),
+			"aws_db_cluster_snapshot":                                 resourceAwsDbClusterSnapshot(),
+			"aws_db_event_subscription":                               resourceAwsDbEventSubscription(),
+			"aws_db_instance":                                         resourceAwsDbInstance(),
+			"aws_db_option_group":                                     resourceAwsDbOptionGroup(),
+			"aws_db_parameter_group":                                  resourceAwsDbParameterGroup(),
+			"aws_db_security_group":                                   resourceAwsDbSecurityGroup(),
+			"aws_db_snapshot":                                         resourceAwsDbSnapshot(),
+			"aws_db_subnet_group":                                     resourceAwsDbSubnetGroup(),
+			"aws_devicefarm_project":                                  resourceAwsDevicefarmProject(),
+			"aws_directory_service_directory":                         resourceAwsDirectoryServiceDirectory(),
+			"aws_directory_service_conditional_forwarder":             resourceAwsDirectoryServiceConditionalForwarder(),
+			"aws_dlm_lifecycle_policy":                                resourceAwsDlmLifecyclePolicy(),
+			"aws_dms_certificate":                                     resourceAwsDmsCertificate(),
+			"aws_dms_endpoint":                                        resourceAwsDmsEndpoint(),
+			"aws_dms_replication_instance":                            resourceAwsDmsReplicationInstance(),
+			"aws_dms_replication_subnet_group":                        resourceAwsDmsReplicationSubnetGroup(),
+			"aws_dms_replication_task":                                resourceAwsDmsReplicationTask(),
+			"aws_dx_bgp_peer":                                         resourceAwsDxBgpPeer(),
+			"aws_dx_connection":                                       resourceAwsDxConnection(),
+			"aws_dx_connection_association":                           resourceAwsDxConnectionAssociation(),
+			"aws_dx_gateway":                                          resourceAwsDxGateway(),
+			"aws_dx_gateway_association":                              resourceAwsDxGatewayAssociation(),
+			"aws_dx_hosted_private_virtual_interface":                 resourceAwsDxHostedPrivateVirtualInterface(),
+			"aws_dx_hosted_private_virtual_interface_accepter":        resourceAwsDxHostedPrivateVirtualInterfaceAccepter(),
+			"aws_dx_hosted_public_virtual_interface":                  resourceAwsDxHostedPublicVirtualInterface(),
+			"aws_dx_hosted_public_virtual_interface_accepter":         resourceAwsDxHostedPublicVirtualInterfaceAccepter(),
+			"aws_dx_lag":                                              resourceAwsDxLag(),
+			"aws_dx_private_virtual_interface":                        resourceAwsDxPrivateVirtualInterface(),
+			"aws_dx_public_virtual_interface":                         resourceAwsDxPublicVirtualInterface(),
+			"aws_dynamodb_table":                                      regionalResource(resourceAwsDynamoDbTable()),
+			"aws_dynamodb_table_item":                                 regionalResource(resourceAwsDynamoDbTableItem()),
+			"aws_dynamodb_global_table":                               regionalResource(resourceAwsDynamoDbGlobalTable()),
+			"aws_ec2_fleet":                                           resourceAwsEc2Fleet(),
+			"aws_ebs_snapshot":                                        resourceAwsEbsSnapshot(),
+			"aws_ebs_snapshot_copy":                                   resourceAwsEbsSnapshotCopy(),
+			"aws_ebs_volume":                                          regionalResource(resourceAwsEbsVolume()),
+			"aws_ecr_lifecycle_policy":                                resourceAwsEcrLifecyclePolicy(),
+			"aws_ecr_repository":                                      resourceAwsEcrRepository(),
+			"aws_ecr_repository_policy":                               resourceAwsEcrRepositoryPolicy(),
+			"aws_ecs_cluster":                                         resourceAwsEcsCluster(),
+			"aws_ecs_service":                                         resourceAwsEcsService(),
+			"aws_ecs_task_definition":                                 resourceAwsEcsTaskDefinition(),
+			"aws_efs_file_system":                                     resourceAwsEfsFileSystem(),
+			"aws_efs_mount_target":                                    resourceAwsEfsMountTarget(),
+			"aws_egress_only_internet_gateway":                        resourceAwsEgressOnlyInternetGateway(),
+			"aws_eip":                                                 regionalResource(resourceAwsEip()),
+			"aws_eip_association":                                     resourceAwsEipAssociation(),
+			"aws_eks_cluster":                                         resourceAwsEksCluster(),
+			"aws_elasticache_cluster":                                 resourceAwsElasticacheCluster(),
+			"aws_elasticache_parameter_group":                         resourceAwsElasticacheParameterGroup(),
+			"aws_elasticache_replication_group":                       resourceAwsElasticacheReplicationGroup(),
+			"aws_elasticache_security_group":                          resourceAwsElasticacheSecurityGroup(),
+			"aws_elasticache_subnet_group":                            resourceAwsElasticacheSubnetGroup(),
+			"aws_elastic_beanstalk_application":                       resourceAwsElasticBeanstalkApplication(),
+			"aws_elastic_beanstalk_application_version":               resourceAwsElasticBeanstalkApplicationVersion(),
+			"aws_elastic_beanstalk_configuration_template":            resourceAwsElasticBeanstalkConfigurationTemplate(),
+			"aws_elastic_beanstalk_environment":                       resourceAwsElasticBeanstalkEnvironment(),
+			"aws_elasticsearch_domain":                                resourceAwsElasticSearchDomain(),
+			"aws_elasticsearch_domain_policy":                         resourceAwsElasticSearchDomainPolicy(),
+			"aws_elastictranscoder_pipeline":                          resourceAwsElasticTranscoderPipeline(),
+			"aws_elastictranscoder_preset":                            resourceAwsElasticTranscoderPreset(),
+			"aws_elb":                                                 resourceAwsElb(),
+			"aws_elb_attachment":                                      resourceAwsElbAttachment(),
+			"aws_emr_cluster":                                         resourceAwsEMRCluster(),
+			"aws_emr_instance_group":                                  resourceAwsEMRInstanceGroup(),
+			"aws_emr_security_configuration":                          resourceAwsEMRSecurityConfiguration(),
+			"aws_flow_log":                                            resourceAwsFlowLog(),
+			"aws_fms_admin_account":                                   resourceAwsFmsAdminAccount(),
+			"aws_fms_policy":                                          resourceAwsFmsPolicy(),
+			"aws_gamelift_alias":                                      resourceAwsGameliftAlias(),
+			"aws_gamelift_build":                                      resourceAwsGameliftBuild(),
+			"aws_gamelift_fleet":                                      resourceAwsGameliftFleet(),
+			"aws_glacier_vault":                                       resourceAwsGlacierVault(),
+			"aws_glue_catalog_database":                               resourceAwsGlueCatalogDatabase(),
+			"aws_glue_catalog_table":                                  resourceAwsGlueCatalogTable(),
+			"aws_glue_classifier":                                     resourceAwsGlueClassifier(),
+			"aws_glue_connection":                                     resourceAwsGlueConnection(),
+			"aws_glue_crawler":                                        resourceAwsGlueCrawler(),
+			"aws_glue_job":                                            resourceAwsGlueJob(),
+			"aws_glue_trigger":                                        resourceAwsGlueTrigger(),
+			"aws_guardduty_detector":                                  resourceAwsGuardDutyDetector(),
+			"aws_guardduty_ipset":                                     resourceAwsGuardDutyIpset(),
+			"aws_guardduty_member":                                    resourceAwsGuardDutyMember(),
+			"aws_guardduty_threatintelset":                            resourceAwsGuardDutyThreatintelset(),
+			"aws_iam_access_key":                                      resourceAwsIamAccessKey(),
+			"aws_iam_account_alias":                                   resourceAwsIamAccountAlias(),
+			"aws_iam_account_password_policy":                         resourceAwsIamAccountPasswordPolicy(),
+			"aws_iam_group_policy":                                    resourceAwsIamGroupPolicy(),
+			"aws_iam_group":                                           resourceAwsIamGroup(),
+			"aws_iam_group_membership":                                resourceAwsIamGroupMembership(),
+			"aws_iam_group_policy_attachment":                         resourceAwsIamGroupPolicyAttachment(),
+			"aws_iam_instance_profile":                                resourceAwsIamInstanceProfile(),
+			"aws_iam_openid_connect_provider":                         resourceAwsIamOpenIDConnectProvider(),
+			"aws_iam_policy":                                          resourceAwsIamPolicy(),
+			"aws_iam_policy_attachment":                               resourceAwsIamPolicyAttachment(),
+			"aws_iam_role_policy_attachment":                          resourceAwsIamRolePolicyAttachment(),
+			"aws_iam_role_policy":                                     resourceAwsIamRolePolicy(),
+			"aws_iam_role":                                            resourceAwsIamRole(),
+			"aws_iam_saml_provider":                                   resourceAwsIamSamlProvider(),
+			"aws_iam_server_certificate":                              resourceAwsIAMServerCertificate(),
+			"aws_iam_service_linked_role":                             resourceAwsIamServiceLinkedRole(),
+			"aws_iam_user_group_membership":                           resourceAwsIamUserGroupMembership(),
+			"aws_iam_user_policy_attachment":                          resourceAwsIamUserPolicyAttachment(),
+			"aws_iam_user_policy":                                     resourceAwsIamUserPolicy(),
+			"aws_iam_user_ssh_key":                                    resourceAwsIamUserSshKey(),
+			"aws_iam_user":                                            resourceAwsIamUser(),
+			"aws_iam_user_login_profile":                              resourceAwsIamUserLoginProfile(),
+			"aws_inspector_assessment_target":                         resourceAWSInspectorAssessmentTarget(),
+			"aws_inspector_assessment_template":                       resourceAWSInspectorAssessmentTemplate(),
+			"aws_inspector_resource_group":                            resourceAWSInspectorResourceGroup(),
+			"aws_instance":                                            regionalResource(resourceAwsInstance()),
+			"aws_internet_gateway":                                    resourceAwsInternetGateway(),
+			"aws_iot_certificate":                                     resourceAwsIotCertificate(),
+			"aws_iot_policy":                                          resourceAwsIotPolicy(),
+			"aws_iot_thing":                                           resourceAwsIotThing(),
+			"aws_iot_thing_type":                                      resourceAwsIotThingType(),
+			"aws_iot_topic_rule":                                      resourceAwsIotTopicRule(),
+			"aws_key_pair":                                            regionalResource(resourceAwsKeyPair()),
+			"aws_kinesis_analytics_application":                       resourceAwsKinesisAnalyticsApplication(),
+			"aws_kinesis_firehose_delivery_stream":                    resourceAwsKinesisFirehoseDeliveryStream(),
+			"aws_kinesis_stream":                                      resourceAwsKinesisStream(),
+			"aws_kms_alias":                                           resourceAwsKmsAlias(),
+			"aws_kms_grant":                                           resourceAwsKmsGrant(),
+			"aws_kms_key":                                             resourceAwsKmsKey(),
+			"aws_lambda_function":                                     regionalResource(resourceAwsLambdaFunction()),
+			"aws_lambda_event_source_mapping":                         resourceAwsLambdaEventSourceMapping(),
+			"aws_lambda_alias":                                        regionalResource(resourceAwsLambdaAlias()),
+			"aws_lambda_permission":                                   regionalResource(resourceAwsLambdaPermission()),
+			"aws_launch_configuration":                                resourceAwsLaunchConfiguration(),
+			"aws_launch_template":                                     resourceAwsLaunchTemplate(),
+			"aws_lex_bot":                                             resourceAwsLexBot(),
+			"aws_lex_bot_alias":                                       resourceAwsLexBotAlias(),
+			"aws_lex_intent":                                          resourceAwsLexIntent(),
+			"aws_lex_slot_type":                                       resourceAwsLexSlotType(),
+			"aws_lightsail_domain":                                    resourceAwsLightsailDomain(),
+			"aws_lightsail_instance":                                  resourceAwsLightsailInstance(),
+			"aws_lightsail_key_pair":                                  resourceAwsLightsailKeyPair(),
+			"aws_lightsail_static_ip":                                 resourceAwsLightsailStaticIp(),
+			"aws_lightsail_static_ip_attachment":                      resourceAwsLightsailStaticIpAttachment(),
+			"aws_lb_cookie_stickiness_policy":                         resourceAwsLBCookieStickinessPolicy(),
+			"aws_load_balancer_policy":                                resourceAwsLoadBalancerPolicy(),
+			"aws_load_balancer_backend_server_policy":                 resourceAwsLoadBalancerBackendServerPolicies(),
+			"aws_load_balancer_listener_policy":                       resourceAwsLoadBalancerListenerPolicies(),
+			"aws_lb_ssl_negotiation_policy":                           resourceAwsLBSSLNegotiationPolicy(),
+			"aws_macie_member_account_association":                    resourceAwsMacieMemberAccountAssociation(),
+			"aws_macie_s3_bucket_association":                         resourceAwsMacieS3BucketAssociation(),
+			"aws_main_route_table_association":                        resourceAwsMainRouteTableAssociation(),
+			"aws_mq_broker":                                           resourceAwsMqBroker(),
+			"aws_mq_configuration":                                    resourceAwsMqConfiguration(),
+			"aws_media_store_container":                               resourceAwsMediaStoreContainer(),
+			"aws_media_store_container_policy":                        resourceAwsMediaStoreContainerPolicy(),
+			"aws_nat_gateway":                                         resourceAwsNatGateway(),
+			"aws_network_acl":                                         resourceAwsNetworkAcl(),
+			"aws_default_network_acl":                                 resourceAwsDefaultNetworkAcl(),
+			"aws_neptune_cluster":                                     resourceAwsNeptuneCluster(),
+			"aws_neptune_cluster_instance":                            resourceAwsNeptuneClusterInstance(),
+			"aws_neptune_cluster_parameter_group":                     resourceAwsNeptuneClusterParameterGroup(),
+			"aws_neptune_cluster_snapshot":                            resourceAwsNeptuneClusterSnapshot(),
+			"aws_neptune_event_subscription":                          resourceAwsNeptuneEventSubscription(),
+			"aws_neptune_parameter_group":                             resourceAwsNeptuneParameterGroup(),
+			"aws_neptune_subnet_group":                                resourceAwsNeptuneSubnetGroup(),
+			"aws_network_acl_rule":                                    resourceAwsNetworkAclRule(),
+			"aws_network_interface":                                   resourceAwsNetworkInterface(),
+			"aws_network_interface_attachment":                        resourceAwsNetworkInterfaceAttachment(),
+			"aws_opsworks_application":                                resourceAwsOpsworksApplication(),
+			"aws_opsworks_stack":                                      resourceAwsOpsworksStack(),
+			"aws_opsworks_java_app_layer":                             resourceAwsOpsworksJavaAppLayer(),
+			"aws_opsworks_haproxy_layer":                              resourceAwsOpsworksHaproxyLayer(),
+			"aws_opsworks_static_web_layer":                           resourceAwsOpsworksStaticWebLayer(),
+			"aws_opsworks_php_app_layer":                              resourceAwsOpsworksPhpAppLayer(),
+			"aws_opsworks_rails_app_layer":                            resourceAwsOpsworksRailsAppLayer(),
+			"aws_opsworks_nodejs_app_layer":                           resourceAwsOpsworksNodejsAppLayer(),
+			"aws_opsworks_memcached_layer":                            resourceAwsOpsworksMemcachedLayer(),
+			"aws_opsworks_mysql_layer":                                resourceAwsOpsworksMysqlLayer(),
+			"aws_opsworks_ganglia_layer":                              resourceAwsOpsworksGangliaLayer(),
+			"aws_opsworks_custom_layer":                               resourceAwsOpsworksCustomLayer(),
+			"aws_opsworks_instance":                                   resourceAwsOpsworksInstance(),
+			"aws_opsworks_user_profile":                               resourceAwsOpsworksUserProfile(),
+			"aws_opsworks_permission":                                 resourceAwsOpsworksPermission(),
+			"aws_opsworks_rds_db_instance":                            resourceAwsOpsworksRdsDbInstance(),
+			"aws_organizations_organization":                          resourceAwsOrganizationsOrganization(),
+			"aws_organizations_account":                               resourceAwsOrganizationsAccount(),
+			"aws_organizations_policy":                                resourceAwsOrganizationsPolicy(),
+			"aws_organizations_policy_attachment":                     resourceAwsOrganizationsPolicyAttachment(),
+			"aws_placement_group":                                     resourceAwsPlacementGroup(),
+			"aws_proxy_protocol_policy":                               resourceAwsProxyProtocolPolicy(),
+			"aws_rds_cluster":                                         resourceAwsRDSCluster(),
+			"aws_rds_cluster_instance":                                resourceAwsRDSClusterInstance(),
+			"aws_rds_cluster_parameter_group":                         resourceAwsRDSClusterParameterGroup(),
+			"aws_redshift_cluster":                                    resourceAwsRedshiftCluster(),
+			"aws_redshift_security_group":                             resourceAwsRedshiftSecurityGroup(),
+			"aws_redshift_parameter_group":                            resourceAwsRedshiftParameterGroup(),
+			"aws_redshift_subnet_group":                               resourceAwsRedshiftSubnetGroup(),
+			"aws_redshift_snapshot_copy_grant":                        resourceAwsRedshiftSnapshotCopyGrant(),
+			"aws_redshift_event_subscription":                         resourceAwsRedshiftEventSubscription(),
+			"aws_route53_delegation_set":                              resourceAwsRoute53DelegationSet(),
+			"aws_route53_query_log":                                   resourceAwsRoute53QueryLog(),
+			"aws_route53_record":                                      resourceAwsRoute53Record(),
+			"aws_route53_zone_association":                            resourceAwsRoute53ZoneAssociation(),
+			"aws_route53_zone":                                        resourceAwsRoute53Zone(),
+			"aws_route53_health_check":                                resourceAwsRoute53HealthCheck(),
+			"aws_route":                                               resourceAwsRoute(),
+			"aws_route_table":                                         resourceAwsRouteTable(),
+			"aws_default_route_table":                                 resourceAwsDefaultRouteTable(),
+			"aws_route_table_association":                             resourceAwsRouteTableAssociation(),
+			"aws_sagemaker_endpoint":                                  resourceAwsSagemakerEndpoint(),
+			"aws_sagemaker_endpoint_configuration":                    resourceAwsSagemakerEndpointConfiguration(),
+			"aws_sagemaker_model":                                     resourceAwsSagemakerModel(),
+			"aws_sagemaker_notebook_instance":                         resourceAwsSagemakerNotebookInstance(),
+			"aws_sagemaker_notebook_instance_lifecycle_configuration": resourceAwsSagemakerNotebookInstanceLifeCycleConfiguration(),
+			"aws_secretsmanager_secret":                               resourceAwsSecretsManagerSecret(),
+			"aws_secretsmanager_secret_version":                       resourceAwsSecretsManagerSecretVersion(),
+			"aws_ses_active_receipt_rule_set":                         resourceAwsSesActiveReceiptRuleSet(),
+			"aws_ses_domain_identity":                                 resourceAwsSesDomainIdentity(),
+			"aws_ses_domain_identity_verification":                    resourceAwsSesDomainIdentityVerification(),
+			"aws_ses_domain_dkim":                                     resourceAwsSesDomainDkim(),
+			"aws_ses_domain_mail_from":                                resourceAwsSesDomainMailFrom(),
+			"aws_ses_receipt_filter":                                  resourceAwsSesReceiptFilter(),
+			"aws_ses_receipt_rule":                                    resourceAwsSesReceiptRule(),
+			"aws_ses_receipt_rule_set":                                resourceAwsSesReceiptRuleSet(),
+			"aws_ses_configuration_set":                               resourceAwsSesConfigurationSet(),
+			"aws_ses_event_destination":                               resourceAwsSesEventDestination(),
+			"aws_ses_identity_notification_topic":                     resourceAwsSesNotificationTopic(),
+			"aws_ses_template":                                        resourceAwsSesTemplate(),
+			"aws_s3_bucket":                                           regionalResource(resourceAwsS3Bucket()),
+			"aws_s3_bucket_policy":                                    regionalResource(resourceAwsS3BucketPolicy()),
+			"aws_s3_bucket_object":                                    resourceAwsS3BucketObject(),
+			"aws_s3_bucket_notification":                              resourceAwsS3BucketNotification(),
+			"aws_s3_bucket_metric":                                    resourceAwsS3BucketMetric(),
+			"aws_s3_bucket_inventory":                                 resourceAwsS3BucketInventory(),
+			"aws_security_group":                                      regionalResource(resourceAwsSecurityGroup()),
+			"aws_network_interface_sg_attachment":                     resourceAwsNetworkInterfaceSGAttachment(),
+			"aws_default_security_group":                              resourceAwsDefaultSecurityGroup(),
+			"aws_security_group_rule":                                 resourceAwsSecurityGroupRule(),
+			"aws_servicecatalog_portfolio":                            resourceAwsServiceCatalogPortfolio(),
+			"aws_service_discovery_private_dns_namespace":             resourceAwsServiceDiscoveryPrivateDnsNamespace(),
+			"aws_service_discovery_public_dns_namespace":              resourceAwsServiceDiscoveryPublicDnsNamespace(),
+			"aws_service_discovery_service":                           resourceAwsServiceDiscoveryService(),
+			"aws_simpledb_domain":                                     resourceAwsSimpleDBDomain(),
+			"aws_ssm_activation":                                      resourceAwsSsmActivation(),
+			"aws_ssm_association":                                     resourceAwsSsmAssociation(),
+			"aws_ssm_document":                                        resourceAwsSsmDocument(),
+			"aws_ssm_maintenance_window":                              resourceAwsSsmMaintenanceWindow(),
+			"aws_ssm_maintenance_window_target":                       resourceAwsSsmMaintenanceWindowTarget(),
+			"aws_ssm_maintenance_window_task":                         resourceAwsSsmMaintenanceWindowTask(),
+			"aws_ssm_patch_baseline":                                  resourceAwsSsmPatchBaseline(),
+			"aws_ssm_patch_group":                                     resourceAwsSsmPatchGroup(),
+			"aws_ssm_parameter":                                       resourceAwsSsmParameter(),
+			"aws_ssm_resource_data_sync":                              resourceAwsSsmResourceDataSync(),
+			"aws_storagegateway_cache":                                resourceAwsStorageGatewayCache(),
+			"aws_storagegateway_cached_iscsi_volume":                  resourceAwsStorageGatewayCachedIscsiVolume(),
+			"aws_storagegateway_gateway":                              resourceAwsStorageGatewayGateway(),
+			"aws_storagegateway_nfs_file_share":                       resourceAwsStorageGatewayNfsFileShare(),
+			"aws_storagegateway_smb_file_share":                       resourceAwsStorageGatewaySmbFileShare(),
+			"aws_storagegateway_upload_buffer":                        resourceAwsStorageGatewayUploadBuffer(),
+			"aws_storagegateway_working_storage":                      resourceAwsStorageGatewayWorkingStorage(),
+			"aws_spot_datafeed_subscription":                          resourceAwsSpotDataFeedSubscription(),
+			"aws_spot_instance_request":                               resourceAwsSpotInstanceRequest(),
+			"aws_spot_fleet_request":                                  resourceAwsSpotFleetRequest(),
+			"aws_sqs_queue":                                           regionalResource(resourceAwsSqsQueue()),
+			"aws_sqs_queue_policy":                                    regionalResource(resourceAwsSqsQueuePolicy()),
+			"aws_snapshot_create_volume_permission":                   resourceAwsSnapshotCreateVolumePermission(),
+			"aws_sns_platform_application":                            resourceAwsSnsPlatformApplication(),
+			"aws_sns_sms_preferences":                                 resourceAwsSnsSmsPreferences(),
+			"aws_sns_topic":                                           regionalResource(resourceAwsSnsTopic()),
+			"aws_sns_topic_policy":                                    regionalResource(resourceAwsSnsTopicPolicy()),
+			"aws_sns_topic_subscription":                              regionalResource(resourceAwsSnsTopicSubscription()),
+			"aws_sfn_activity":                                        resourceAwsSfnActivity(),
+			"aws_sfn_state_machine":                                   resourceAwsSfnStateMachine(),
+			"aws_default_subnet":                                      resourceAwsDefaultSubnet(),
+			"aws_subnet":                                              regionalResource(resourceAwsSubnet()),
+			"aws_swf_domain":                                          resourceAwsSwfDomain(),
+			"aws_volume_attachment":                                   resourceAwsVolumeAttachment(),
+			"aws_vpc_dhcp_options_association":                        resourceAwsVpcDhcpOptionsAssociation(),
+			"aws_default_vpc_dhcp_options":                            resourceAwsDefaultVpcDhcpOptions(),
+			"aws_vpc_dhcp_options":                                    resourceAwsVpcDhcpOptions(),
+			"aws_vpc_peering_connection":                              regionalResource(resourceAwsVpcPeeringConnection()),
+			"aws_vpc_peering_connection_accepter":                     resourceAwsVpcPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                      resourceAwsVpcPeeringConnectionOptions(),
+			"aws_default_vpc":                                         resourceAwsDefaultVpc(),
+			"aws_vpc":                                                 regionalResource(resourceAwsVpc()),
+			"aws_vpc_endpoint":                                        resourceAwsVpcEndpoint(),
+			"aws_vpc_endpoint_connection_notification":                resourceAwsVpcEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":                resourceAwsVpcEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_subnet_association":                     resourceAwsVpcEndpointSubnetAssociation(),
+			"aws_vpc_endpoint_service":                                resourceAwsVpcEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":              resourceAwsVpcEndpointServiceAllowedPrincipal(),
+			"aws_vpc_ipv4_cidr_block_association":                     resourceAwsVpcIpv4CidrBlockAssociation(),
+			"aws_vpn_connection":                                      resourceAwsVpnConnection(),
+			"aws_vpn_connection_route":                                resourceAwsVpnConnectionRoute(),
+			"aws_vpn_gateway":                                         resourceAwsVpnGateway(),
+			"aws_vpn_gateway_attachment":                              resourceAwsVpnGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                       resourceAwsVpnGatewayRoutePropagation(),
+			"aws_waf_byte_match_set":                                  resourceAwsWafByteMatchSet(),
+			"aws_waf_ipset":                                           resourceAwsWafIPSet(),
+			"aws_waf_rate_based_rule":                                 resourceAwsWafRateBasedRule(),
+			"aws_waf_regex_match_set":                                 resourceAwsWafRegexMatchSet(),
+			"aws_waf_regex_pattern_set":                               resourceAwsWafRegexPatternSet(),
+			"aws_waf_rule":                                            resourceAwsWafRule(),
+			"aws_waf_rule_group":                                      resourceAwsWafRuleGroup(),
+			"aws_waf_size_constraint_set":                             resourceAwsWafSizeConstraintSet(),
+			"aws_waf_web_acl":                                         resourceAwsWafWebAcl(),
+			"aws_waf_xss_match_set":                                   resourceAwsWafXssMatchSet(),
+			"aws_waf_sql_injection_match_set":                         resourceAwsWafSqlInjectionMatchSet(),
+			"aws_waf_geo_match_set":                                   resourceAwsWafGeoMatchSet(),
+			"aws_wafregional_byte_match_set":                          resourceAwsWafRegionalByteMatchSet(),
+			"aws_wafregional_geo_match_set":                           resourceAwsWafRegionalGeoMatchSet(),
+			"aws_wafregional_ipset":                                   resourceAwsWafRegionalIPSet(),
+			"aws_wafregional_rate_based_rule":                         resourceAwsWafRegionalRateBasedRule(),
+			"aws_wafregional_regex_match_set":                         resourceAwsWafRegionalRegexMatchSet(),
+			"aws_wafregional_regex_pattern_set":                       resourceAwsWafRegionalRegexPatternSet(),
+			"aws_wafregional_rule":                                    resourceAwsWafRegionalRule(),
+			"aws_wafregional_rule_group":                              resourceAwsWafRegionalRuleGroup(),
+			"aws_wafregional_size_constraint_set":                     resourceAwsWafRegionalSizeConstraintSet(),
+			"aws_wafregional_sql_injection_match_set":                 resourceAwsWafRegionalSqlInjectionMatchSet(),
+			"aws_wafregional_xss_match_set":                           resourceAwsWafRegionalXssMatchSet(),
+			"aws_wafregional_web_acl":                                 resourceAwsWafRegionalWebAcl(),
+			"aws_wafregional_web_acl_association":                     resourceAwsWafRegionalWebAclAssociation(),
+			"aws_batch_compute_environment":                           resourceAwsBatchComputeEnvironment(),
+			"aws_batch_job_definition":                                resourceAwsBatchJobDefinition(),
+			"aws_batch_job_queue":                                     resourceAwsBatchJobQueue(),
+			"aws_pinpoint_app":                                        resourceAwsPinpointApp(),
+			"aws_pinpoint_adm_channel":                                resourceAwsPinpointADMChannel(),
+			"aws_pinpoint_apns_channel":                               resourceAwsPinpointAPNSChannel(),
+			"aws_pinpoint_baidu_channel":                              resourceAwsPinpointBaiduChannel(),
+			"aws_pinpoint_email_channel":                              resourceAwsPinpointEmailChannel(),
+			"aws_pinpoint_event_stream":                               resourceAwsPinpointEventStream(),
+			"aws_pinpoint_gcm_channel":                                resourceAwsPinpointGCMChannel(),
+			"aws_pinpoint_sms_channel":                                resourceAwsPinpointSMSChannel(),
 
 			// ALBs are actually LBs because they can be type `network` or `application`
 			// To avoid regressions, we will add a new resource for each and they both point
@@ -708,6 +782,17 @@ func Provider() terraform.ResourceProvider {
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	// Every resource with a map of tags also carries the provider default_tags
+	for _, r := range provider.ResourcesMap {
+		resourceWithDefaultTags(r)
+	}
+
+	for _, r := range provider.DataSourcesMap {
+		dataSourceWithIgnoreTags(r)
+	}
+
+	return provider
 }
 
 var descriptions map[string]string
@@ -729,6 +814,9 @@ func init() {
 		"shared_credentials_file": "The path to the shared credentials file. If not set\n" +
 			"this defaults to ~/.aws/credentials.",
 
+		"shared_config_file": "The path to the shared config file. If not set\n" +
+			"this defaults to ~/.aws/config.",
+
 		"token": "session token. A session token is only required if you are\n" +
 			"using temporary security credentials.",
 
@@ -736,17 +824,17 @@ func init() {
 			"being executed. If the API request still fails, an error is\n" +
 			"thrown.",
 
-		"apigateway_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"endpoint": "Use this to override the default service endpoint URL",
 
-		"cloudformation_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"rate_limit": "Configuration block with the client-side rate limit of the requests\n" +
+			"made to a service.",
 
-		"cloudwatch_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"rate_limit_service": "The service to rate limit, named as in the `endpoints` block.",
 
-		"cloudwatchevents_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"rate_limit_requests_per_second": "The steady rate of requests per second made to the service.",
 
-		"cloudwatchlogs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"devicefarm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"rate_limit_burst": "The number of requests that can be made at once before the steady\n" +
+			"rate applies. Defaults to one second's worth of requests.",
 
 		"dynamodb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to dynamodb-local.",
@@ -754,34 +842,28 @@ func init() {
 		"kinesis_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n" +
 			"It's typically used to connect to kinesalite.",
 
-		"kms_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"iam_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"lambda_endpoint": "Use this to override the default endpoint URL constructed from the `region`\n",
-
-		"ec2_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"autoscaling_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"efs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"elb_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
+			"default value is `false`",
 
-		"es_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"http_proxy": "The URL of the proxy for HTTP requests. If omitted, the HTTP_PROXY\n" +
+			"environment variable is used.",
 
-		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"https_proxy": "The URL of the proxy for HTTPS requests. If omitted, the HTTPS_PROXY\n" +
+			"environment variable is used.",
 
-		"s3_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"no_proxy": "Comma separated hosts, domains, IP addresses and CIDR blocks reached\n" +
+			"without a proxy. If omitted, the NO_PROXY environment variable is used.",
 
-		"sns_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"custom_ca_bundle": "The path to a PEM file holding the certificate authorities to trust\n" +
+			"instead of the system ones. It can also be sourced from the AWS_CA_BUNDLE\n" +
+			"environment variable.",
 
-		"sqs_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
+		"tls_min_version": "The minimum TLS version of the connections to AWS, one of\n" +
+			"`1.0`, `1.1`, `1.2` or `1.3`.",
 
-		"ssm_endpoint": "Use this to override the default endpoint URL constructed from the `region`.\n",
-
-		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted," +
-			"default value is `false`",
+		"api_trace_file": "The path to a file to which a JSON line is appended for every AWS API\n" +
+			"call, with sensitive request parameters redacted. It can also be sourced from\n" +
+			"the TF_AWS_API_TRACE_FILE environment variable.",
 
 		"skip_credentials_validation": "Skip the credentials validation via STS API. " +
 			"Used for AWS API implementations that do not have STS available/implemented.",
@@ -814,6 +896,51 @@ func init() {
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use," +
 			" this policy to grant further permissions that are in excess to those of the, " +
 			" role that is being assumed.",
+
+		"assume_role": "Roles to assume prior to making API calls. When more than one is given," +
+			" the roles are assumed in order, each using the credentials of the one before it.",
+
+		"assume_role_policy_arns": "The ARNs of IAM managed policies to use as session policies" +
+			" when assuming the role.",
+
+		"assume_role_duration_seconds": "The duration, in seconds, of the role session." +
+			" Defaults to 900 seconds (15 minutes).",
+
+		"assume_role_serial_number": "The identification number of the MFA device associated with" +
+			" the user who is assuming the role.",
+
+		"assume_role_token_code": "The code produced by the MFA device, required when" +
+			" serial_number is set.",
+
+		"assume_role_tags": "Session tags to pass when assuming the role.",
+
+		"assume_role_transitive_tag_keys": "Keys of the session tags that are passed on to" +
+			" subsequent sessions in a role chain.",
+
+		"assume_role_with_web_identity": "Obtain the credentials of a role in exchange for a web" +
+			" identity token, such as an OIDC token issued to a CI job. When set, these credentials" +
+			" are used instead of any other credential source.",
+
+		"assume_role_with_web_identity_role_arn": "The ARN of the IAM role to assume.",
+
+		"assume_role_with_web_identity_web_identity_token_file": "The path to a file holding the" +
+			" web identity token. The file is read again whenever the credentials are refreshed.",
+
+		"assume_role_with_web_identity_session_name": "The session name to use when assuming the role.",
+
+		"assume_role_with_web_identity_duration_seconds": "The duration, in seconds, of the role session." +
+			" Defaults to 3600 seconds (1 hour).",
+
+		"default_tags": "Configuration block with settings to default resource tags across all resources.",
+
+		"default_tags_tags": "Resource tags to default across all resources. Tags set on a resource" +
+			" take precedence over the default tags with the same key.",
+
+		"ignore_tags": "Configuration block with settings to ignore resource tags across all resources.",
+
+		"ignore_tags_keys": "Resource tag keys to ignore across all resources.",
+
+		"ignore_tags_key_prefixes": "Resource tag key prefixes to ignore across all resources.",
 	}
 }
 
@@ -826,6 +953,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Region:                  d.Get("region").(string),
 		MaxRetries:              d.Get("max_retries").(int),
 		Insecure:                d.Get("insecure").(bool),
+		HTTPProxy:               d.Get("http_proxy").(string),
+		HTTPSProxy:              d.Get("https_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
+		TLSMinVersion:           d.Get("tls_min_version").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -841,55 +972,68 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.CredsFilename = credsPath
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.AssumeRoleARN = assumeRole["role_arn"].(string)
-		config.AssumeRoleSessionName = assumeRole["session_name"].(string)
-		config.AssumeRoleExternalID = assumeRole["external_id"].(string)
+	// Set SharedConfigFile, expanding home directory
+	configPath, err := homedir.Expand(d.Get("shared_config_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.SharedConfigFile = configPath
 
-		if v := assumeRole["policy"].(string); v != "" {
-			config.AssumeRolePolicy = v
-		}
+	// Set CustomCABundle, expanding home directory
+	caBundlePath, err := homedir.Expand(d.Get("custom_ca_bundle").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.CustomCABundle = caBundlePath
 
-		log.Printf("[INFO] assume_role configuration set: (ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q)",
-			config.AssumeRoleARN, config.AssumeRoleSessionName, config.AssumeRoleExternalID, config.AssumeRolePolicy)
+	// Set APITraceFile, expanding home directory
+	apiTracePath, err := homedir.Expand(d.Get("api_trace_file").(string))
+	if err != nil {
+		return nil, err
+	}
+	config.APITraceFile = apiTracePath
+
+	config.AssumeRoles = expandAssumeRoles(d.Get("assume_role").([]interface{}))
+	if len(config.AssumeRoles) > 0 {
+		for i, role := range config.AssumeRoles {
+			log.Printf("[INFO] assume_role configuration set: (%d: ARN: %q, SessionID: %q, ExternalID: %q, Policy: %q, PolicyARNs: %q, Duration: %s, SerialNumber: %q)",
+				i, role.RoleARN, role.SessionName, role.ExternalID, role.Policy, role.PolicyARNs, role.Duration, role.SerialNumber)
+		}
 	} else {
 		log.Printf("[INFO] No assume_role block read from configuration")
 	}
 
+	config.AssumeRoleWithWebIdentity, err = expandAssumeRoleWithWebIdentity(d.Get("assume_role_with_web_identity").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	if role := config.AssumeRoleWithWebIdentity; role != nil {
+		log.Printf("[INFO] assume_role_with_web_identity configuration set: (ARN: %q, SessionID: %q, WebIdentityTokenFile: %q, Duration: %s)",
+			role.RoleARN, role.SessionName, role.WebIdentityTokenFile, role.Duration)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
+	config.Endpoints = make(map[string]string)
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.AcmEndpoint = endpoints["acm"].(string)
-		config.ApigatewayEndpoint = endpoints["apigateway"].(string)
-		config.CloudFormationEndpoint = endpoints["cloudformation"].(string)
-		config.CloudWatchEndpoint = endpoints["cloudwatch"].(string)
-		config.CloudWatchEventsEndpoint = endpoints["cloudwatchevents"].(string)
-		config.CloudWatchLogsEndpoint = endpoints["cloudwatchlogs"].(string)
-		config.DeviceFarmEndpoint = endpoints["devicefarm"].(string)
-		config.DynamoDBEndpoint = endpoints["dynamodb"].(string)
-		config.Ec2Endpoint = endpoints["ec2"].(string)
-		config.AutoscalingEndpoint = endpoints["autoscaling"].(string)
-		config.EcrEndpoint = endpoints["ecr"].(string)
-		config.EcsEndpoint = endpoints["ecs"].(string)
-		config.EfsEndpoint = endpoints["efs"].(string)
-		config.ElbEndpoint = endpoints["elb"].(string)
-		config.EsEndpoint = endpoints["es"].(string)
-		config.IamEndpoint = endpoints["iam"].(string)
-		config.KinesisEndpoint = endpoints["kinesis"].(string)
-		config.KmsEndpoint = endpoints["kms"].(string)
-		config.LambdaEndpoint = endpoints["lambda"].(string)
-		config.R53Endpoint = endpoints["r53"].(string)
-		config.RdsEndpoint = endpoints["rds"].(string)
-		config.S3Endpoint = endpoints["s3"].(string)
-		config.SnsEndpoint = endpoints["sns"].(string)
-		config.SqsEndpoint = endpoints["sqs"].(string)
-		config.StsEndpoint = endpoints["sts"].(string)
-		config.SsmEndpoint = endpoints["ssm"].(string)
+		for _, endpointServiceName := range endpointServiceNames {
+			config.Endpoints[endpointServiceName] = endpoints[endpointServiceName].(string)
+		}
 	}
 
+	config.RateLimits, err = expandRateLimits(d.Get("rate_limit").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	for service, rateLimit := range config.RateLimits {
+		log.Printf("[INFO] rate_limit configuration set: (Service: %q, RequestsPerSecond: %g, Burst: %d)",
+			service, rateLimit.RequestsPerSecond, rateLimit.Burst)
+	}
+
+	config.DefaultTags = expandDefaultTags(d.Get("default_tags").([]interface{}))
+	config.IgnoreTags = expandIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
 	if v, ok := d.GetOk("allowed_account_ids"); ok {
 		config.AllowedAccountIds = v.(*schema.Set).List()
 	}
@@ -906,9 +1050,8 @@ var awsMutexKV = mutexkv.NewMutexKV()
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -934,181 +1077,258 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
-			},
-		},
-	}
-}
 
-func endpointsSchema() *schema.Schema {
-	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
-		Elem: &schema.Resource{
-			Schema: map[string]*schema.Schema{
-				"acm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["acm_endpoint"],
-				},
-				"apigateway": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["apigateway_endpoint"],
-				},
-				"cloudwatch": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatch_endpoint"],
-				},
-				"cloudwatchevents": {
-					Type:        schema.TypeString,
+				"policy_arns": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchevents_endpoint"],
-				},
-				"cloudwatchlogs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudwatchlogs_endpoint"],
-				},
-				"cloudformation": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cloudformation_endpoint"],
-				},
-				"devicefarm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["devicefarm_endpoint"],
-				},
-				"dynamodb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dynamodb_endpoint"],
-				},
-				"iam": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["iam_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateIamPolicyArn},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_policy_arns"],
 				},
 
-				"ec2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ec2_endpoint"],
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_duration_seconds"],
 				},
 
-				"autoscaling": {
+				"serial_number": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["autoscaling_endpoint"],
+					Description: descriptions["assume_role_serial_number"],
 				},
 
-				"ecr": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecr_endpoint"],
+				"token_code": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]{6}$`), "must be a 6 digit code"),
+					Description:  descriptions["assume_role_token_code"],
 				},
 
-				"ecs": {
-					Type:        schema.TypeString,
+				"tags": {
+					Type:        schema.TypeMap,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["ecs_endpoint"],
+					Description: descriptions["assume_role_tags"],
 				},
 
-				"efs": {
-					Type:        schema.TypeString,
+				"transitive_tag_keys": {
+					Type:        schema.TypeSet,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["efs_endpoint"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["assume_role_transitive_tag_keys"],
 				},
+			},
+		},
+		Description: descriptions["assume_role"],
+	}
+}
 
-				"elb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["elb_endpoint"],
-				},
-				"es": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["es_endpoint"],
-				},
-				"kinesis": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kinesis_endpoint"],
-				},
-				"kms": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["kms_endpoint"],
-				},
-				"lambda": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["lambda_endpoint"],
-				},
-				"r53": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["r53_endpoint"],
-				},
-				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
-				},
-				"s3": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["s3_endpoint"],
-				},
-				"sns": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sns_endpoint"],
+// expandAssumeRoles returns the roles configured in the provider assume_role
+// blocks, in the order in which they are to be assumed. Blocks without a
+// role_arn are skipped.
+func expandAssumeRoles(l []interface{}) []*AssumeRole {
+	var roles []*AssumeRole
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok || m["role_arn"].(string) == "" {
+			continue
+		}
+
+		role := &AssumeRole{
+			RoleARN:      m["role_arn"].(string),
+			SessionName:  m["session_name"].(string),
+			ExternalID:   m["external_id"].(string),
+			Policy:       m["policy"].(string),
+			Duration:     time.Duration(m["duration_seconds"].(int)) * time.Second,
+			SerialNumber: m["serial_number"].(string),
+			TokenCode:    m["token_code"].(string),
+		}
+		if v, ok := m["policy_arns"].(*schema.Set); ok && v.Len() > 0 {
+			role.PolicyARNs = aws.StringValueSlice(expandStringSet(v))
+		}
+		if v, ok := m["tags"].(map[string]interface{}); ok && len(v) > 0 {
+			role.Tags = newKeyValueTags(v).Map()
+		}
+		if v, ok := m["transitive_tag_keys"].(*schema.Set); ok && v.Len() > 0 {
+			role.TransitiveTagKeys = aws.StringValueSlice(expandStringSet(v))
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles
+}
+
+func assumeRoleWithWebIdentitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+					Description:  descriptions["assume_role_with_web_identity_role_arn"],
 				},
-				"sqs": {
+
+				"web_identity_token_file": {
 					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["sqs_endpoint"],
+					Required:    true,
+					Description: descriptions["assume_role_with_web_identity_web_identity_token_file"],
 				},
-				"sts": {
+
+				"session_name": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Default:     "",
-					Description: descriptions["sts_endpoint"],
+					Description: descriptions["assume_role_with_web_identity_session_name"],
 				},
-				"ssm": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ssm_endpoint"],
+
+				"duration_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(900, 43200),
+					Description:  descriptions["assume_role_with_web_identity_duration_seconds"],
 				},
 			},
 		},
+		Description: descriptions["assume_role_with_web_identity"],
+	}
+}
+
+// expandAssumeRoleWithWebIdentity returns the provider
+// assume_role_with_web_identity configuration, or nil if the block is absent.
+func expandAssumeRoleWithWebIdentity(l []interface{}) (*AssumeRoleWithWebIdentity, error) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil
+	}
+
+	m := l[0].(map[string]interface{})
+	tokenFile, err := homedir.Expand(m["web_identity_token_file"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	return &AssumeRoleWithWebIdentity{
+		RoleARN:              m["role_arn"].(string),
+		SessionName:          m["session_name"].(string),
+		WebIdentityTokenFile: tokenFile,
+		Duration:             time.Duration(m["duration_seconds"].(int)) * time.Second,
+	}, nil
+}
+
+// endpointServiceNames lists the services whose endpoint URL can be
+// overridden in the provider endpoints block. Each client in AWSClient is
+// constructed with the override for one of these names.
+var endpointServiceNames = []string{
+	"acm",
+	"acmpca",
+	"apigateway",
+	"applicationautoscaling",
+	"appsync",
+	"athena",
+	"autoscaling",
+	"batch",
+	"budgets",
+	"cloud9",
+	"cloudformation",
+	"cloudfront",
+	"cloudhsm",
+	"cloudtrail",
+	"cloudwatch",
+	"cloudwatchevents",
+	"cloudwatchlogs",
+	"codebuild",
+	"codecommit",
+	"codedeploy",
+	"codepipeline",
+	"cognitoidentity",
+	"cognitoidp",
+	"configservice",
+	"dax",
+	"devicefarm",
+	"directconnect",
+	"dlm",
+	"dms",
+	"ds",
+	"dynamodb",
+	"ec2",
+	"ecr",
+	"ecs",
+	"efs",
+	"eks",
+	"elasticache",
+	"elasticbeanstalk",
+	"elastictranscoder",
+	"elb",
+	"emr",
+	"es",
+	"firehose",
+	"fms",
+	"gamelift",
+	"glacier",
+	"glue",
+	"guardduty",
+	"iam",
+	"inspector",
+	"iot",
+	"kinesis",
+	"kinesisanalytics",
+	"kms",
+	"lambda",
+	"lexmodels",
+	"lightsail",
+	"macie",
+	"mediastore",
+	"mq",
+	"neptune",
+	"opsworks",
+	"organizations",
+	"pinpoint",
+	"pricing",
+	"r53",
+	"rds",
+	"redshift",
+	"s3",
+	"sagemaker",
+	"sdb",
+	"secretsmanager",
+	"servicecatalog",
+	"servicediscovery",
+	"ses",
+	"sns",
+	"sqs",
+	"ssm",
+	"stepfunctions",
+	"storagegateway",
+	"sts",
+	"swf",
+	"waf",
+	"wafregional",
+	"workspaces",
+}
+
+func endpointsSchema() *schema.Schema {
+	endpointsAttributes := make(map[string]*schema.Schema)
+
+	for _, endpointServiceName := range endpointServiceNames {
+		endpointsAttributes[endpointServiceName] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "",
+			Description: descriptions["endpoint"],
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: endpointsAttributes,
+		},
 		Set: endpointsToHash,
 	}
 }
@@ -1116,25 +1336,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchevents"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudwatchlogs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cloudformation"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["devicefarm"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dynamodb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["iam"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ec2"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["autoscaling"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["efs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kinesis"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["lambda"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["s3"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sqs"].(string)))
+	for _, endpointServiceName := range endpointServiceNames {
+		buf.WriteString(fmt.Sprintf("%s-", m[endpointServiceName].(string)))
+	}
 
 	return hashcode.String(buf.String())
 }
@@ -4,14 +4,17 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/service/organizations"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -61,6 +64,53 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
+func TestExpandAssumeRoles(t *testing.T) {
+	expected := []*AssumeRole{
+		{
+			RoleARN:           "arn:aws:iam::111111111111:role/hub",
+			SessionName:       "ci",
+			Duration:          time.Hour,
+			SerialNumber:      "arn:aws:iam::000000000000:mfa/ci",
+			TokenCode:         "123456",
+			PolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+			Tags:              map[string]string{"Team": "platform"},
+			TransitiveTagKeys: []string{"Team"},
+		},
+		{
+			RoleARN:    "arn:aws:iam::222222222222:role/workload",
+			ExternalID: "workload-external-id",
+		},
+	}
+
+	raw := map[string]interface{}{
+		"assume_role": []interface{}{
+			map[string]interface{}{
+				"role_arn":            "arn:aws:iam::111111111111:role/hub",
+				"session_name":        "ci",
+				"duration_seconds":    3600,
+				"serial_number":       "arn:aws:iam::000000000000:mfa/ci",
+				"token_code":          "123456",
+				"policy_arns":         []interface{}{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+				"tags":                map[string]interface{}{"Team": "platform"},
+				"transitive_tag_keys": []interface{}{"Team"},
+			},
+			map[string]interface{}{
+				"role_arn":    "arn:aws:iam::222222222222:role/workload",
+				"external_id": "workload-external-id",
+			},
+			map[string]interface{}{
+				"session_name": "ignored",
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"assume_role": assumeRoleSchema()}, raw)
+
+	actual := expandAssumeRoles(d.Get("assume_role").([]interface{}))
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %s", spew.Sdump(actual))
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("AWS_PROFILE"); v == "" {
 		if v := os.Getenv("AWS_ACCESS_KEY_ID"); v == "" {
@@ -161,6 +211,19 @@ func testAccGetRegion() string {
 	return v
 }
 
+// testAccGetAlternateRegion returns a region other than testAccGetRegion,
+// for testing resources managed in another region than the provider's.
+func testAccGetAlternateRegion() string {
+	v := os.Getenv("AWS_ALTERNATE_REGION")
+	if v == "" {
+		v = "us-east-1"
+		if testAccGetRegion() == v {
+			v = "us-west-2"
+		}
+	}
+	return v
+}
+
 func testAccGetPartition() string {
 	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), testAccGetRegion()); ok {
 		return partition.ID()
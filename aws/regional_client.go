@@ -0,0 +1,230 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acm"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// regionalImportIDSeparator separates the ID of a regional resource from its
+// region in an import ID, e.g. vpc-12345678@eu-west-1.
+const regionalImportIDSeparator = "@"
+
+// regionPattern matches region names, so that an import ID is only split on
+// its last separator when what follows it is a region.
+var regionPattern = regexp.MustCompile(`^[a-z]{2}(-gov|-iso|-isob)?-[a-z]+-[0-9]+$`)
+
+// regionalClients lazily builds, and caches by region, the clients used to
+// manage regional resources in a region other than the provider's.
+type regionalClients struct {
+	mu      sync.Mutex
+	clients map[string]*AWSClient
+
+	// session returns the session of the clients of a service in a region.
+	session func(service, region string) *session.Session
+
+	skipRegionValidation bool
+}
+
+func newRegionalClients(skipRegionValidation bool, session func(service, region string) *session.Session) *regionalClients {
+	return &regionalClients{
+		clients:              make(map[string]*AWSClient),
+		session:              session,
+		skipRegionValidation: skipRegionValidation,
+	}
+}
+
+// withRegion returns the client managing regional resources in region: c
+// itself for the provider region, or otherwise a copy of c whose EC2, S3,
+// ACM, Lambda, SNS, SQS and DynamoDB clients are built for region.
+func (c *AWSClient) withRegion(region string) (*AWSClient, error) {
+	if region == "" || region == c.region {
+		return c, nil
+	}
+	if c.regionalClients == nil {
+		return nil, fmt.Errorf("resources cannot be managed in region %s, other than the provider region %s", region, c.region)
+	}
+
+	// The clients of each region are cached for all resources, so they are
+	// built from the shared clients, then traced for this resource.
+	base := c
+	if c.apiTraceBase != nil {
+		base = c.apiTraceBase
+	}
+	client, err := c.regionalClients.client(base, region)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.withAPITraceAddress(c.apiTraceAddress), nil
+}
+
+func (rc *regionalClients) client(c *AWSClient, region string) (*AWSClient, error) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if client, ok := rc.clients[region]; ok {
+		return client, nil
+	}
+
+	if !rc.skipRegionValidation {
+		if err := (&Config{Region: region}).ValidateRegion(); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Printf("[INFO] Initializing AWS SDK connections for region %s", region)
+	client := *c
+	client.region = region
+	client.acmconn = acm.New(rc.session("acm", region))
+	client.dynamodbconn = dynamodb.New(rc.session("dynamodb", region))
+	client.ec2conn = ec2.New(rc.session("ec2", region))
+	client.lambdaconn = lambda.New(rc.session("lambda", region))
+	client.s3conn = s3.New(rc.session("s3", region))
+	client.snsconn = sns.New(rc.session("sns", region))
+	client.sqsconn = sqs.New(rc.session("sqs", region))
+
+	client.dynamodbconn.Handlers.Retry.PushBack(retryDynamoDBSubscriberLimit)
+
+	if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && partition.ID() != c.partition {
+		log.Printf("[WARN] Region %s is in partition %s, not in the provider partition %s", region, partition.ID(), c.partition)
+	}
+
+	rc.clients[region] = &client
+	return &client, nil
+}
+
+// regionalResource adds an optional region argument to r, whose resources are
+// then managed in that region rather than in the provider region. r must only
+// use the service clients built for each region by withRegion.
+//
+// The resources are imported in another region with an ID suffixed with the
+// region, e.g. vpc-12345678@eu-west-1.
+func regionalResource(r *schema.Resource) *schema.Resource {
+	r.Schema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+			if value := v.(string); !regionPattern.MatchString(value) {
+				errors = append(errors, fmt.Errorf("%q must be a region name, such as us-east-1, got: %q", k, value))
+			}
+			return
+		},
+	}
+
+	if r.Create != nil {
+		r.Create = schema.CreateFunc(regionalCRUDFunc(r.Create))
+	}
+	if r.Read != nil {
+		r.Read = schema.ReadFunc(regionalCRUDFunc(r.Read))
+	}
+	if r.Update != nil {
+		r.Update = schema.UpdateFunc(regionalCRUDFunc(r.Update))
+	}
+	if r.Delete != nil {
+		r.Delete = schema.DeleteFunc(regionalCRUDFunc(r.Delete))
+	}
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, err := resourceRegionClient(d.Get("region").(string), meta)
+			if err != nil {
+				return false, err
+			}
+			return exists(d, client)
+		}
+	}
+
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			client, err := resourceRegionClient(d.Get("region").(string), meta)
+			if err != nil {
+				return err
+			}
+			return customizeDiff(d, client)
+		}
+	}
+
+	if r.Importer != nil {
+		state := r.Importer.State
+		r.Importer = &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				id, region := parseRegionalImportID(d.Id())
+				if region != "" {
+					d.SetId(id)
+					d.Set("region", region)
+				}
+
+				client, err := resourceRegionClient(region, meta)
+				if err != nil {
+					return nil, err
+				}
+
+				if state == nil {
+					return []*schema.ResourceData{d}, nil
+				}
+				return state(d, client)
+			},
+		}
+	}
+
+	return r
+}
+
+// regionalCRUDFunc wraps a create, read, update or delete function of a
+// regional resource so that it is called with the client of the resource
+// region.
+func regionalCRUDFunc(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, err := resourceRegionClient(d.Get("region").(string), meta)
+		if err != nil {
+			return err
+		}
+
+		if err := f(d, client); err != nil {
+			return err
+		}
+
+		// The function may have set the region itself, as S3 buckets do from
+		// their location.
+		if d.Id() != "" && d.Get("region").(string) == "" {
+			d.Set("region", client.region)
+		}
+		return nil
+	}
+}
+
+func resourceRegionClient(region string, meta interface{}) (*AWSClient, error) {
+	return meta.(*AWSClient).withRegion(region)
+}
+
+// parseRegionalImportID splits an import ID of the form <id>@<region>. The
+// region is empty if the ID does not end with one.
+func parseRegionalImportID(importID string) (string, string) {
+	i := strings.LastIndex(importID, regionalImportIDSeparator)
+	if i < 0 || !regionPattern.MatchString(importID[i+1:]) {
+		return importID, ""
+	}
+	return importID[:i], importID[i+1:]
+}
+
+// regionalSession returns a copy of sess for the clients of another region.
+func regionalSession(sess *session.Session, region string) *session.Session {
+	return sess.Copy(&aws.Config{Region: aws.String(region)})
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestParseRegionalImportID(t *testing.T) {
+	cases := []struct {
+		ImportID string
+		ID       string
+		Region   string
+	}{
+		{"vpc-12345678", "vpc-12345678", ""},
+		{"vpc-12345678@eu-west-1", "vpc-12345678", "eu-west-1"},
+		{"vpc-12345678@us-gov-west-1", "vpc-12345678", "us-gov-west-1"},
+		{"arn:aws:sns:eu-west-1:123456789012:topic@eu-west-1", "arn:aws:sns:eu-west-1:123456789012:topic", "eu-west-1"},
+		{"user@example.com", "user@example.com", ""},
+		{"a@b@ap-southeast-2", "a@b", "ap-southeast-2"},
+		{"vpc-12345678@", "vpc-12345678@", ""},
+	}
+
+	for _, tc := range cases {
+		id, region := parseRegionalImportID(tc.ImportID)
+		if id != tc.ID || region != tc.Region {
+			t.Fatalf("%q: expected (%q, %q), got (%q, %q)", tc.ImportID, tc.ID, tc.Region, id, region)
+		}
+	}
+}
+
+func TestAWSClientWithRegion(t *testing.T) {
+	var authorization string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		fmt.Fprint(w, `<ListQueuesResponse><ListQueuesResult></ListQueuesResult></ListQueuesResponse>`)
+	}))
+	defer ts.Close()
+
+	c := &Config{
+		AccessKey:               "MockAccessKey",
+		SecretKey:               "MockSecretKey",
+		Region:                  "us-east-1",
+		Endpoints:               map[string]string{"sqs": ts.URL},
+		SkipCredsValidation:     true,
+		SkipGetEC2Platforms:     true,
+		SkipMetadataApiCheck:    true,
+		SkipRequestingAccountId: true,
+	}
+	raw, err := c.Client()
+	if err != nil {
+		t.Fatalf("error creating client: %s", err)
+	}
+	client := raw.(*AWSClient)
+
+	if actual, err := client.withRegion(""); err != nil || actual != client {
+		t.Fatalf("expected the provider client without a region, got %p (%v)", actual, err)
+	}
+	if actual, err := client.withRegion("us-east-1"); err != nil || actual != client {
+		t.Fatalf("expected the provider client for the provider region, got %p (%v)", actual, err)
+	}
+
+	regional, err := client.withRegion("eu-west-1")
+	if err != nil {
+		t.Fatalf("error getting eu-west-1 client: %s", err)
+	}
+	if regional.region != "eu-west-1" {
+		t.Fatalf("expected region eu-west-1, got %s", regional.region)
+	}
+	if region := aws.StringValue(regional.ec2conn.Config.Region); region != "eu-west-1" {
+		t.Fatalf("expected EC2 client for eu-west-1, got %s", region)
+	}
+	if region := aws.StringValue(regional.iamconn.Config.Region); region != "us-east-1" {
+		t.Fatalf("expected global IAM client to be kept, got %s", region)
+	}
+	if region := aws.StringValue(client.ec2conn.Config.Region); region != "us-east-1" {
+		t.Fatalf("expected provider EC2 client to be unchanged, got %s", region)
+	}
+
+	if again, _ := client.withRegion("eu-west-1"); again != regional {
+		t.Fatal("expected the eu-west-1 client to be cached")
+	}
+
+	// Requests are signed for the region, and still use the endpoint override
+	if _, err := regional.sqsconn.ListQueues(&sqs.ListQueuesInput{}); err != nil {
+		t.Fatalf("error listing queues: %s", err)
+	}
+	if !strings.Contains(authorization, "/eu-west-1/sqs/aws4_request") {
+		t.Fatalf("expected request signed for eu-west-1, got: %s", authorization)
+	}
+
+	if _, err := client.withRegion("xx-nowhere-1"); err == nil {
+		t.Fatal("expected an error for an unknown region")
+	}
+}
+
+func TestRegionalResource(t *testing.T) {
+	regions := make(map[string]string)
+	record := func(op string) func(*schema.ResourceData, interface{}) error {
+		return func(d *schema.ResourceData, meta interface{}) error {
+			regions[op] = meta.(*AWSClient).region
+			if op == "create" {
+				d.SetId("example")
+			}
+			return nil
+		}
+	}
+
+	r := regionalResource(&schema.Resource{
+		Create: record("create"),
+		Read:   record("read"),
+		Delete: record("delete"),
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				regions["import"] = meta.(*AWSClient).region
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	})
+
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatalf("error validating resource: %s", err)
+	}
+
+	provider := &AWSClient{region: "us-east-1"}
+	regional := &AWSClient{region: "eu-west-1"}
+	provider.regionalClients = newRegionalClients(true, nil)
+	provider.regionalClients.clients["eu-west-1"] = regional
+
+	// Without a region, the provider region is used and recorded
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "example"})
+	if err := r.Create(d, provider); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+	if regions["create"] != "us-east-1" || d.Get("region").(string) != "us-east-1" {
+		t.Fatalf("expected create in us-east-1, got %q with region %q", regions["create"], d.Get("region"))
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "example", "region": "eu-west-1"})
+	if err := r.Create(d, provider); err != nil {
+		t.Fatalf("error creating: %s", err)
+	}
+	if err := r.Read(d, provider); err != nil {
+		t.Fatalf("error reading: %s", err)
+	}
+	if err := r.Delete(d, provider); err != nil {
+		t.Fatalf("error deleting: %s", err)
+	}
+	for _, op := range []string{"create", "read", "delete"} {
+		if regions[op] != "eu-west-1" {
+			t.Fatalf("expected %s in eu-west-1, got %q", op, regions[op])
+		}
+	}
+
+	// The region is taken from the import ID
+	d = r.Data(nil)
+	d.SetId("example@eu-west-1")
+	imported, err := r.Importer.State(d, provider)
+	if err != nil {
+		t.Fatalf("error importing: %s", err)
+	}
+	if regions["import"] != "eu-west-1" {
+		t.Fatalf("expected import in eu-west-1, got %q", regions["import"])
+	}
+	if imported[0].Id() != "example" || imported[0].Get("region").(string) != "eu-west-1" {
+		t.Fatalf("expected ID example in eu-west-1, got %q in %q", imported[0].Id(), imported[0].Get("region"))
+	}
+
+	// A client without regional clients cannot manage other regions
+	if err := r.Read(d, &AWSClient{region: "us-east-1"}); err == nil {
+		t.Fatal("expected an error reading in another region without regional clients")
+	}
+}
+
+func TestValidateRegionalResourceRegion(t *testing.T) {
+	r := regionalResource(&schema.Resource{Schema: map[string]*schema.Schema{}})
+	validate := r.Schema["region"].ValidateFunc
+
+	for _, v := range []string{"us-east-1", "eu-central-1", "us-gov-west-1", "cn-north-1"} {
+		if _, errors := validate(v, "region"); len(errors) != 0 {
+			t.Fatalf("%q should be a valid region: %q", v, errors)
+		}
+	}
+	for _, v := range []string{"", "us-east", "US-EAST-1", "useast1"} {
+		if _, errors := validate(v, "region"); len(errors) == 0 {
+			t.Fatalf("%q should be an invalid region", v)
+		}
+	}
+}
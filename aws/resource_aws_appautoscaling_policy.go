@@ -21,6 +21,9 @@ func resourceAwsAppautoscalingPolicy() *schema.Resource {
 		Read:   resourceAwsAppautoscalingPolicyRead,
 		Update: resourceAwsAppautoscalingPolicyUpdate,
 		Delete: resourceAwsAppautoscalingPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingPolicyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -381,6 +384,23 @@ func resourceAwsAppautoscalingPolicyDelete(d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// resourceAwsAppautoscalingPolicyImport imports a scaling policy by an ID of
+// the form <service-namespace>/<resource-id>/<scalable-dimension>/<policy-name>.
+func resourceAwsAppautoscalingPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	namespace, resourceId, dimension, policyName, err := parseAppautoscalingImportId(d.Id(), true)
+	if err != nil {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <service-namespace>/<resource-id>/<scalable-dimension>/<policy-name>", d.Id())
+	}
+
+	d.Set("service_namespace", namespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", dimension)
+	d.Set("name", policyName)
+	d.SetId(policyName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 // Takes the result of flatmap.Expand for an array of step adjustments and
 // returns a []*applicationautoscaling.StepAdjustment.
 func expandAppautoscalingStepAdjustments(configured []interface{}) ([]*applicationautoscaling.StepAdjustment, error) {
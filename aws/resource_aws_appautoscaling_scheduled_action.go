@@ -18,6 +18,9 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 		Create: resourceAwsAppautoscalingScheduledActionPut,
 		Read:   resourceAwsAppautoscalingScheduledActionRead,
 		Delete: resourceAwsAppautoscalingScheduledActionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingScheduledActionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -38,6 +41,7 @@ func resourceAwsAppautoscalingScheduledAction() *schema.Resource {
 			"scalable_dimension": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 			"scalable_target_action": {
@@ -149,6 +153,7 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 	input := &applicationautoscaling.DescribeScheduledActionsInput{
 		ScheduledActionNames: []*string{aws.String(saName)},
 		ServiceNamespace:     aws.String(d.Get("service_namespace").(string)),
+		ResourceId:           aws.String(d.Get("resource_id").(string)),
 	}
 	resp, err := conn.DescribeScheduledActions(input)
 	if err != nil {
@@ -162,13 +167,61 @@ func resourceAwsAppautoscalingScheduledActionRead(d *schema.ResourceData, meta i
 	if len(resp.ScheduledActions) != 1 {
 		return fmt.Errorf("Expected 1 scheduled action under %s, found %d", saName, len(resp.ScheduledActions))
 	}
-	if *resp.ScheduledActions[0].ScheduledActionName != saName {
+	sa := resp.ScheduledActions[0]
+	if aws.StringValue(sa.ScheduledActionName) != saName {
 		return fmt.Errorf("Scheduled Action (%s) not found", saName)
 	}
-	d.Set("arn", resp.ScheduledActions[0].ScheduledActionARN)
+
+	d.Set("arn", sa.ScheduledActionARN)
+	d.Set("name", sa.ScheduledActionName)
+	d.Set("service_namespace", sa.ServiceNamespace)
+	d.Set("resource_id", sa.ResourceId)
+	d.Set("scalable_dimension", sa.ScalableDimension)
+	d.Set("schedule", sa.Schedule)
+	if err := d.Set("scalable_target_action", flattenAppautoscalingScalableTargetAction(sa.ScalableTargetAction)); err != nil {
+		return fmt.Errorf("error setting scalable_target_action: %s", err)
+	}
+	if sa.StartTime != nil {
+		d.Set("start_time", sa.StartTime.Format(awsAppautoscalingScheduleTimeLayout))
+	}
+	if sa.EndTime != nil {
+		d.Set("end_time", sa.EndTime.Format(awsAppautoscalingScheduleTimeLayout))
+	}
+
 	return nil
 }
 
+func resourceAwsAppautoscalingScheduledActionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	namespace, resourceId, dimension, name, err := parseAppautoscalingImportId(d.Id(), true)
+	if err != nil {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <service-namespace>/<resource-id>/<scalable-dimension>/<name>", d.Id())
+	}
+
+	d.Set("service_namespace", namespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", dimension)
+	d.Set("name", name)
+	d.SetId(name + "-" + namespace + "-" + resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenAppautoscalingScalableTargetAction(sta *applicationautoscaling.ScalableTargetAction) []interface{} {
+	if sta == nil {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{})
+	if sta.MaxCapacity != nil {
+		m["max_capacity"] = aws.Int64Value(sta.MaxCapacity)
+	}
+	if sta.MinCapacity != nil {
+		m["min_capacity"] = aws.Int64Value(sta.MinCapacity)
+	}
+
+	return []interface{}{m}
+}
+
 func resourceAwsAppautoscalingScheduledActionDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).appautoscalingconn
 
@@ -25,6 +25,12 @@ func TestAccAWSAppautoscalingScheduledAction_dynamo(t *testing.T) {
 					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.hoge"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_scheduled_action.hoge",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingScheduledActionImportStateIdFunc("aws_appautoscaling_scheduled_action.hoge"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -42,6 +48,12 @@ func TestAccAWSAppautoscalingScheduledAction_ECS(t *testing.T) {
 					testAccCheckAwsAppautoscalingScheduledActionExists("aws_appautoscaling_scheduled_action.hoge"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_scheduled_action.hoge",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingScheduledActionImportStateIdFunc("aws_appautoscaling_scheduled_action.hoge"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -80,6 +92,17 @@ func TestAccAWSAppautoscalingScheduledAction_SpotFleet(t *testing.T) {
 	})
 }
 
+func testAccAWSAppautoscalingScheduledActionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["scalable_dimension"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccCheckAwsAppautoscalingScheduledActionDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -19,6 +20,9 @@ func resourceAwsAppautoscalingTarget() *schema.Resource {
 		Read:   resourceAwsAppautoscalingTargetRead,
 		Update: resourceAwsAppautoscalingTargetPut,
 		Delete: resourceAwsAppautoscalingTargetDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAppautoscalingTargetImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"max_capacity": {
@@ -165,6 +169,47 @@ func resourceAwsAppautoscalingTargetDelete(d *schema.ResourceData, meta interfac
 	})
 }
 
+func resourceAwsAppautoscalingTargetImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	namespace, resourceId, dimension, _, err := parseAppautoscalingImportId(d.Id(), false)
+	if err != nil {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <service-namespace>/<resource-id>/<scalable-dimension>", d.Id())
+	}
+
+	d.Set("service_namespace", namespace)
+	d.Set("resource_id", resourceId)
+	d.Set("scalable_dimension", dimension)
+	d.SetId(resourceId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseAppautoscalingImportId splits the import ID of an Application
+// AutoScaling resource, of the form
+// <service-namespace>/<resource-id>/<scalable-dimension>, which is followed by
+// /<name> when withName is set. Resource IDs, such as service/cluster/service
+// for ECS, contain slashes themselves.
+func parseAppautoscalingImportId(id string, withName bool) (namespace, resourceId, dimension, name string, err error) {
+	parts := strings.Split(id, "/")
+
+	n := len(parts)
+	if withName {
+		n--
+		name = parts[n]
+	}
+	if n < 3 || (withName && name == "") {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%q)", id)
+	}
+
+	namespace = parts[0]
+	resourceId = strings.Join(parts[1:n-1], "/")
+	dimension = parts[n-1]
+	if namespace == "" || resourceId == "" || dimension == "" {
+		return "", "", "", "", fmt.Errorf("unexpected format of ID (%q)", id)
+	}
+
+	return namespace, resourceId, dimension, name, nil
+}
+
 func getAwsAppautoscalingTarget(resourceId, namespace, dimension string,
 	conn *applicationautoscaling.ApplicationAutoScaling) (*applicationautoscaling.ScalableTarget, error) {
 
@@ -34,6 +34,12 @@ func TestAccAWSAppautoScalingTarget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_appautoscaling_target.bar", "max_capacity", "3"),
 				),
 			},
+			{
+				ResourceName:      "aws_appautoscaling_target.bar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAppautoscalingTargetImportStateIdFunc("aws_appautoscaling_target.bar"),
+				ImportStateVerify: true,
+			},
 
 			{
 				Config: testAccAWSAppautoscalingTargetConfigUpdate(randClusterName),
@@ -147,6 +153,80 @@ func TestAccAWSAppautoScalingTarget_optionalRoleArn(t *testing.T) {
 	})
 }
 
+func TestParseAppautoscalingImportId(t *testing.T) {
+	cases := []struct {
+		Id                string
+		WithName          bool
+		ExpectedNamespace string
+		ExpectedResource  string
+		ExpectedDimension string
+		ExpectedName      string
+		ExpectError       bool
+	}{
+		{
+			Id:                "ecs/service/cluster/service/ecs:service:DesiredCount",
+			ExpectedNamespace: "ecs",
+			ExpectedResource:  "service/cluster/service",
+			ExpectedDimension: "ecs:service:DesiredCount",
+		},
+		{
+			Id:                "dynamodb/table/tf-table/dynamodb:table:ReadCapacityUnits/tf-policy",
+			WithName:          true,
+			ExpectedNamespace: "dynamodb",
+			ExpectedResource:  "table/tf-table",
+			ExpectedDimension: "dynamodb:table:ReadCapacityUnits",
+			ExpectedName:      "tf-policy",
+		},
+		{
+			Id:          "dynamodb/table/tf-table/dynamodb:table:ReadCapacityUnits/",
+			WithName:    true,
+			ExpectError: true,
+		},
+		{
+			Id:          "ecs/service/ecs:service:DesiredCount",
+			WithName:    true,
+			ExpectError: true,
+		},
+		{
+			Id:          "ecs//ecs:service:DesiredCount",
+			ExpectError: true,
+		},
+		{
+			Id:          "ecs",
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		namespace, resourceId, dimension, name, err := parseAppautoscalingImportId(tc.Id, tc.WithName)
+		if tc.ExpectError {
+			if err == nil {
+				t.Fatalf("%q: expected an error", tc.Id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %s", tc.Id, err)
+		}
+		if namespace != tc.ExpectedNamespace || resourceId != tc.ExpectedResource || dimension != tc.ExpectedDimension || name != tc.ExpectedName {
+			t.Fatalf("%q: expected %q, %q, %q, %q, got %q, %q, %q, %q", tc.Id,
+				tc.ExpectedNamespace, tc.ExpectedResource, tc.ExpectedDimension, tc.ExpectedName,
+				namespace, resourceId, dimension, name)
+		}
+	}
+}
+
+func testAccAWSAppautoscalingTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["service_namespace"], rs.Primary.Attributes["resource_id"], rs.Primary.Attributes["scalable_dimension"]), nil
+	}
+}
+
 func testAccCheckAWSAppautoscalingTargetDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).appautoscalingconn
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"time"
 
@@ -275,6 +276,8 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 				ConflictsWith: []string{"tag"},
 			},
 
+			"tags_all": tagsSchemaAll(),
+
 			"service_linked_role_arn": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -283,6 +286,7 @@ func resourceAwsAutoscalingGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			resourceAwsAutoscalingGroupTagsAllCustomizeDiff,
 			customdiff.ComputedIf("launch_template.0.id", func(diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.name")
 			}),
@@ -425,6 +429,15 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 		createOpts.Tags = append(createOpts.Tags, tags...)
 	}
 
+	if v, ok := d.GetOk("tags_all"); ok {
+		tags, err := autoscalingTagsFromTagsAll(v.(map[string]interface{}), d.Get("tag").(*schema.Set), d.Get("tags").([]interface{}), resourceID)
+		if err != nil {
+			return err
+		}
+
+		createOpts.Tags = append(createOpts.Tags, tags...)
+	}
+
 	if v, ok := d.GetOk("default_cooldown"); ok {
 		createOpts.DefaultCooldown = aws.Int64(int64(v.(int)))
 	}
@@ -520,6 +533,21 @@ func resourceAwsAutoscalingGroupCreate(d *schema.ResourceData, meta interface{})
 	return resourceAwsAutoscalingGroupRead(d, meta)
 }
 
+// resourceAwsAutoscalingGroupTagsAllCustomizeDiff plans tags_all as the
+// provider default tags overlaid with the tag and tags attributes.
+func resourceAwsAutoscalingGroupTagsAllCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tag") || !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+
+	tagsAll := autoscalingTagsAll(defaultTagsFromMeta(meta), diff.Get("tag").(*schema.Set), diff.Get("tags").([]interface{}))
+	if reflect.DeepEqual(diff.Get("tags_all"), tagsAll) {
+		return nil
+	}
+
+	return diff.SetNew("tags_all", tagsAll)
+}
+
 func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 
@@ -599,8 +627,16 @@ func resourceAwsAutoscalingGroupRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("tags", autoscalingTagDescriptionsToSlice(tagsList))
 	}
 
+	defaultTags := defaultTagsFromMeta(meta)
+
 	if !tagOk && !tagsOk {
-		d.Set("tag", autoscalingTagDescriptionsToSlice(g.Tags))
+		tagList = autoscalingTagDescriptionsWithoutIgnored(g.Tags, ignoreTagsFromMeta(meta))
+		d.Set("tag", autoscalingTagDescriptionsToSlice(autoscalingTagDescriptionsWithoutDefaults(tagList, defaultTags)))
+	}
+
+	keys := autoscalingTagKeys(d.Get("tag").(*schema.Set), d.Get("tags").([]interface{}))
+	if err := d.Set("tags_all", autoscalingTagDescriptionsToTagsAll(g.Tags, keys, defaultTags)); err != nil {
+		return fmt.Errorf("error setting tags_all: %s", err)
 	}
 
 	if len(*g.VPCZoneIdentifier) > 0 {
@@ -16,6 +16,9 @@ func resourceAwsAutoscalingNotification() *schema.Resource {
 		Read:   resourceAwsAutoscalingNotificationRead,
 		Update: resourceAwsAutoscalingNotificationUpdate,
 		Delete: resourceAwsAutoscalingNotificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsAutoscalingNotificationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"topic_arn": {
@@ -93,6 +96,12 @@ func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
+	if len(gRaw) == 0 {
+		log.Printf("[WARN] Autoscaling Notification (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	// Grab the keys here as the list of Groups
 	var gList []string
 	for k := range gRaw {
@@ -115,6 +124,14 @@ func resourceAwsAutoscalingNotificationRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// resourceAwsAutoscalingNotificationImport imports the notifications to a
+// topic, which are then read for all the groups that have any.
+func resourceAwsAutoscalingNotificationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("topic_arn", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsAutoscalingNotificationUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).autoscalingconn
 
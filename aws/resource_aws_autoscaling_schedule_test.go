@@ -29,6 +29,12 @@ func TestAccAWSAutoscalingSchedule_basic(t *testing.T) {
 					testAccCheckScalingScheduleExists("aws_autoscaling_schedule.foobar", &schedule),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_schedule.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingScheduleImportStateIdFunc("aws_autoscaling_schedule.foobar"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -56,6 +62,17 @@ func TestAccAWSAutoscalingSchedule_disappears(t *testing.T) {
 	})
 }
 
+func testAccAWSAutoscalingScheduleImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["autoscaling_group_name"], rs.Primary.Attributes["scheduled_action_name"]), nil
+	}
+}
+
 func testAccCheckScalingScheduleDisappears(schedule *autoscaling.ScheduledUpdateGroupAction) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		autoscalingconn := testAccProvider.Meta().(*AWSClient).autoscalingconn
@@ -86,6 +103,12 @@ func TestAccAWSAutoscalingSchedule_recurrence(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_autoscaling_schedule.foobar", "recurrence", "0 8 * * *"),
 				),
 			},
+			{
+				ResourceName:      "aws_autoscaling_schedule.foobar",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSAutoscalingScheduleImportStateIdFunc("aws_autoscaling_schedule.foobar"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
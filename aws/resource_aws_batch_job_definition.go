@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	"encoding/json"
+	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/private/protocol/json/jsonutil"
 	"github.com/aws/aws-sdk-go/service/batch"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
@@ -18,6 +20,10 @@ func resourceAwsBatchJobDefinition() *schema.Resource {
 		Read:   resourceAwsBatchJobDefinitionRead,
 		Delete: resourceAwsBatchJobDefinitionDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -132,17 +138,24 @@ func resourceAwsBatchJobDefinitionCreate(d *schema.ResourceData, meta interface{
 
 func resourceAwsBatchJobDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
-	arn := d.Get("arn").(string)
+	arn := d.Id()
 	job, err := getJobDefinition(conn, arn)
 	if err != nil {
 		return fmt.Errorf("%s %q", err, arn)
 	}
 	if job == nil {
+		log.Printf("[WARN] Batch Job Definition (%s) not found, removing from state", d.Id())
 		d.SetId("")
 		return nil
 	}
 	d.Set("arn", job.JobDefinitionArn)
-	d.Set("container_properties", job.ContainerProperties)
+	d.Set("name", job.JobDefinitionName)
+
+	containerProperties, err := flattenBatchJobContainerProperties(job.ContainerProperties)
+	if err != nil {
+		return fmt.Errorf("error flattening container_properties: %s", err)
+	}
+	d.Set("container_properties", containerProperties)
 	d.Set("parameters", aws.StringValueMap(job.Parameters))
 
 	if err := d.Set("retry_strategy", flattenBatchRetryStrategy(job.RetryStrategy)); err != nil {
@@ -215,6 +228,37 @@ func expandBatchJobContainerProperties(rawProps string) (*batch.ContainerPropert
 	return props, nil
 }
 
+// flattenBatchJobContainerProperties returns the JSON document of container
+// properties, leaving out the empty lists Batch returns for those not given.
+func flattenBatchJobContainerProperties(props *batch.ContainerProperties) (string, error) {
+	if props == nil {
+		return "", nil
+	}
+
+	p := *props
+	if len(p.Command) == 0 {
+		p.Command = nil
+	}
+	if len(p.Environment) == 0 {
+		p.Environment = nil
+	}
+	if len(p.MountPoints) == 0 {
+		p.MountPoints = nil
+	}
+	if len(p.Ulimits) == 0 {
+		p.Ulimits = nil
+	}
+	if len(p.Volumes) == 0 {
+		p.Volumes = nil
+	}
+
+	out, err := jsonutil.BuildJSON(&p)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
 func expandJobDefinitionParameters(params map[string]interface{}) map[string]*string {
 	var jobParams = make(map[string]*string)
 	for k, v := range params {
@@ -14,6 +14,32 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestFlattenBatchJobContainerProperties(t *testing.T) {
+	props := &batch.ContainerProperties{
+		Command:     []*string{aws.String("ls"), aws.String("-la")},
+		Environment: []*batch.KeyValuePair{},
+		Image:       aws.String("busybox"),
+		Memory:      aws.Int64(128),
+		MountPoints: []*batch.MountPoint{},
+		Ulimits:     []*batch.Ulimit{},
+		Vcpus:       aws.Int64(1),
+		Volumes:     []*batch.Volume{},
+	}
+
+	actual, err := flattenBatchJobContainerProperties(props)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `{"command":["ls","-la"],"image":"busybox","memory":128,"vcpus":1}`
+	if actual != expected {
+		t.Fatalf("expected %s, got %s", expected, actual)
+	}
+	if props.Volumes == nil {
+		t.Fatalf("expected the container properties to be left unchanged")
+	}
+}
+
 func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
 	var jd batch.JobDefinition
 	compare := batch.JobDefinition{
@@ -63,6 +89,11 @@ func TestAccAWSBatchJobDefinition_basic(t *testing.T) {
 					testAccCheckBatchJobDefinitionAttributes(&jd, &compare),
 				),
 			},
+			{
+				ResourceName:      "aws_batch_job_definition.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
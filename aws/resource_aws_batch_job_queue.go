@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,10 @@ func resourceAwsBatchJobQueue() *schema.Resource {
 		Update: resourceAwsBatchJobQueueUpdate,
 		Delete: resourceAwsBatchJobQueueDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"compute_environments": {
 				Type:     schema.TypeList,
@@ -86,7 +91,7 @@ func resourceAwsBatchJobQueueCreate(d *schema.ResourceData, meta interface{}) er
 func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).batchconn
 
-	jq, err := getJobQueue(conn, d.Get("name").(string))
+	jq, err := getJobQueue(conn, d.Id())
 	if err != nil {
 		return err
 	}
@@ -96,7 +101,9 @@ func resourceAwsBatchJobQueueRead(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 	d.Set("arn", jq.JobQueueArn)
-	d.Set("compute_environments", jq.ComputeEnvironmentOrder)
+	if err := d.Set("compute_environments", flattenComputeEnvironmentOrder(jq.ComputeEnvironmentOrder)); err != nil {
+		return fmt.Errorf("error setting compute_environments: %s", err)
+	}
 	d.Set("name", jq.JobQueueName)
 	d.Set("priority", jq.Priority)
 	d.Set("state", jq.State)
@@ -162,6 +169,22 @@ func createComputeEnvironmentOrder(order []interface{}) (envs []*batch.ComputeEn
 	return
 }
 
+// flattenComputeEnvironmentOrder returns the compute environments of a job
+// queue in their order.
+func flattenComputeEnvironmentOrder(envs []*batch.ComputeEnvironmentOrder) []string {
+	sorted := make([]*batch.ComputeEnvironmentOrder, len(envs))
+	copy(sorted, envs)
+	sort.Slice(sorted, func(i, j int) bool {
+		return aws.Int64Value(sorted[i].Order) < aws.Int64Value(sorted[j].Order)
+	})
+
+	result := make([]string, 0, len(sorted))
+	for _, env := range sorted {
+		result = append(result, aws.StringValue(env.ComputeEnvironment))
+	}
+	return result
+}
+
 func deleteBatchJobQueue(jobQueue string, timeout time.Duration, conn *batch.Batch) error {
 	_, err := conn.DeleteJobQueue(&batch.DeleteJobQueueInput{
 		JobQueue: aws.String(jobQueue),
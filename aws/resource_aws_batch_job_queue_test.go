@@ -88,6 +88,11 @@ func TestAccAWSBatchJobQueue_basic(t *testing.T) {
 					testAccCheckBatchJobQueueAttributes(&jq),
 				),
 			},
+			{
+				ResourceName:      "aws_batch_job_queue.test_queue",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
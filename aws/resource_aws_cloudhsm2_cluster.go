@@ -303,45 +303,48 @@ func resourceAwsCloudHsm2ClusterDelete(d *schema.ResourceData, meta interface{})
 }
 
 func setTagsAwsCloudHsm2Cluster(conn *cloudhsmv2.CloudHSMV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	return tagsServiceCloudHsm2(conn).UpdateResource(d, d.Id())
+}
 
-			_, err := conn.UntagResource(&cloudhsmv2.UntagResourceInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeyList: keys,
+// tagsServiceCloudHsm2 returns the calls that manage the tags of a CloudHSM v2
+// cluster.
+func tagsServiceCloudHsm2(conn *cloudhsmv2.CloudHSMV2) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.ListTags(&cloudhsmv2.ListTagsInput{
+				ResourceId: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			tagList := make([]*cloudhsmv2.Tag, 0, len(create))
-			for k, v := range create {
+			tags := make(keyValueTags, len(resp.TagList))
+			for _, t := range resp.TagList {
+				tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+			}
+			return tags, nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			tagList := make([]*cloudhsmv2.Tag, 0, len(tags))
+			for _, k := range tags.Keys() {
 				tagList = append(tagList, &cloudhsmv2.Tag{
-					Key:   &k,
-					Value: v,
+					Key:   aws.String(k),
+					Value: aws.String(tags[k]),
 				})
 			}
 			_, err := conn.TagResource(&cloudhsmv2.TagResourceInput{
-				ResourceId: aws.String(d.Id()),
+				ResourceId: aws.String(identifier),
 				TagList:    tagList,
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.UntagResource(&cloudhsmv2.UntagResourceInput{
+				ResourceId: aws.String(identifier),
+				TagKeyList: aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 func readCloudHsm2ClusterCertificates(cluster *cloudhsmv2.Cluster) []map[string]interface{} {
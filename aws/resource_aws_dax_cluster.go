@@ -174,7 +174,7 @@ func resourceAwsDaxClusterCreate(d *schema.ResourceData, meta interface{}) error
 	securityIdSet := d.Get("security_group_ids").(*schema.Set)
 
 	securityIds := expandStringList(securityIdSet.List())
-	tags := tagsFromMapDax(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapDax(d.Get("tags_all").(map[string]interface{}))
 
 	req := &dax.CreateClusterInput{
 		ClusterName:       aws.String(clusterName),
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -446,7 +447,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 	// we expect everything to be in sync before returning completion.
 	var requiresRebootDbInstance bool
 
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	var identifier string
 	if v, ok := d.GetOk("identifier"); ok {
@@ -516,8 +517,8 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 
 		if attr, ok := d.GetOk("kms_key_id"); ok {
 			opts.KmsKeyId = aws.String(attr.(string))
-			if arnParts := strings.Split(v.(string), ":"); len(arnParts) >= 4 {
-				opts.SourceRegion = aws.String(arnParts[3])
+			if sourceArn, err := arn.Parse(v.(string)); err == nil {
+				opts.SourceRegion = aws.String(sourceArn.Region)
 			}
 		}
 
@@ -733,17 +734,7 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 		log.Println(
 			"[INFO] Waiting for DB Instance to be available")
 
-		stateConf := &resource.StateChangeConf{
-			Pending:    resourceAwsDbInstanceCreatePendingStates,
-			Target:     []string{"available", "storage-optimization"},
-			Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-			Timeout:    d.Timeout(schema.TimeoutCreate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // Wait 30 secs before starting
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = rdsDbInstanceAvailableAfterCreateWaiter.waitForResource(d, schema.TimeoutCreate, rdsDbInstanceStatus(conn, d.Id()))
 		if err != nil {
 			return err
 		}
@@ -1059,17 +1050,8 @@ func resourceAwsDbInstanceCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(d.Get("identifier").(string))
 
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsDbInstanceCreatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-
 	log.Printf("[INFO] Waiting for DB Instance (%s) to be available", d.Id())
-	_, err := stateConf.WaitForState()
+	_, err := rdsDbInstanceAvailableAfterCreateWaiter.waitForResource(d, schema.TimeoutCreate, rdsDbInstanceStatus(conn, d.Id()))
 	if err != nil {
 		return err
 	}
@@ -1279,28 +1261,12 @@ func resourceAwsDbInstanceDelete(d *schema.ResourceData, meta interface{}) error
 }
 
 func waitUntilAwsDbInstanceIsAvailableAfterUpdate(id string, conn *rds.RDS, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsDbInstanceUpdatePendingStates,
-		Target:     []string{"available", "storage-optimization"},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(id, conn),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-	_, err := stateConf.WaitForState()
+	_, err := rdsDbInstanceAvailableAfterUpdateWaiter.wait(rdsDbInstanceStatus(conn, id), timeout)
 	return err
 }
 
 func waitUntilAwsDbInstanceIsDeleted(id string, conn *rds.RDS, timeout time.Duration) error {
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsDbInstanceDeletePendingStates,
-		Target:     []string{},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(id, conn),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-	_, err := stateConf.WaitForState()
+	_, err := rdsDbInstanceDeletedWaiter.wait(rdsDbInstanceStatus(conn, id), timeout)
 	return err
 }
 
@@ -1503,7 +1469,7 @@ func resourceAwsDbInstanceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsRDS(conn, d, d.Get("arn").(string)); err != nil {
 			return err
 		} else {
@@ -1554,27 +1520,6 @@ func resourceAwsDbInstanceImport(
 	return []*schema.ResourceData{d}, nil
 }
 
-func resourceAwsDbInstanceStateRefreshFunc(id string, conn *rds.RDS) resource.StateRefreshFunc {
-	return func() (interface{}, string, error) {
-		v, err := resourceAwsDbInstanceRetrieve(id, conn)
-
-		if err != nil {
-			log.Printf("Error on retrieving DB Instance when waiting: %s", err)
-			return nil, "", err
-		}
-
-		if v == nil {
-			return nil, "", nil
-		}
-
-		if v.DBInstanceStatus != nil {
-			log.Printf("[DEBUG] DB Instance status for instance %s: %s", id, *v.DBInstanceStatus)
-		}
-
-		return v, *v.DBInstanceStatus, nil
-	}
-}
-
 func buildCloudwatchLogsExportConfiguration(d *schema.ResourceData) *rds.CloudwatchLogsExportConfiguration {
 
 	oraw, nraw := d.GetChange("enabled_cloudwatch_logs_exports")
@@ -1607,51 +1552,3 @@ func diffCloudwatchLogsExportConfiguration(old, new []interface{}) ([]interface{
 
 	return create, disable
 }
-
-// Database instance status: http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Status.html
-var resourceAwsDbInstanceCreatePendingStates = []string{
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-log-exports",
-	"creating",
-	"maintenance",
-	"modifying",
-	"rebooting",
-	"renaming",
-	"resetting-master-credentials",
-	"starting",
-	"stopping",
-	"upgrading",
-}
-
-var resourceAwsDbInstanceDeletePendingStates = []string{
-	"available",
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-log-exports",
-	"creating",
-	"deleting",
-	"incompatible-parameters",
-	"modifying",
-	"starting",
-	"stopping",
-	"storage-full",
-	"storage-optimization",
-}
-
-var resourceAwsDbInstanceUpdatePendingStates = []string{
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-log-exports",
-	"creating",
-	"maintenance",
-	"modifying",
-	"moving-to-vpc",
-	"rebooting",
-	"renaming",
-	"resetting-master-credentials",
-	"starting",
-	"stopping",
-	"storage-full",
-	"upgrading",
-}
@@ -0,0 +1,367 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/dlm"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsDlmLifecyclePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsDlmLifecyclePolicyCreate,
+		Read:   resourceAwsDlmLifecyclePolicyRead,
+		Update: resourceAwsDlmLifecyclePolicyUpdate,
+		Delete: resourceAwsDlmLifecyclePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDlmLifecyclePolicyDescription,
+			},
+			"execution_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+			"policy_details": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{dlm.ResourceTypeValuesVolume}, false),
+							},
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"create_rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"interval": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validateDlmCreateRuleInterval,
+												},
+												"interval_unit": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Default:  dlm.IntervalUnitValuesHours,
+													ValidateFunc: validation.StringInSlice([]string{
+														dlm.IntervalUnitValuesHours,
+													}, false),
+												},
+												"times": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Computed: true,
+													MaxItems: 1,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: validateDlmCreateRuleTime,
+													},
+												},
+											},
+										},
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 500),
+									},
+									"retain_rule": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"count": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IntBetween(1, 1000),
+												},
+											},
+										},
+									},
+									"tags_to_add": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"target_tags": {
+							Type:     schema.TypeMap,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  dlm.SettablePolicyStateValuesEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					dlm.SettablePolicyStateValuesDisabled,
+					dlm.SettablePolicyStateValuesEnabled,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceAwsDlmLifecyclePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	input := &dlm.CreateLifecyclePolicyInput{
+		Description:      aws.String(d.Get("description").(string)),
+		ExecutionRoleArn: aws.String(d.Get("execution_role_arn").(string)),
+		PolicyDetails:    expandDlmPolicyDetails(d.Get("policy_details").([]interface{})),
+		State:            aws.String(d.Get("state").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating DLM Lifecycle Policy: %s", input)
+	out, err := conn.CreateLifecyclePolicy(input)
+	if err != nil {
+		return fmt.Errorf("error creating DLM Lifecycle Policy: %s", err)
+	}
+
+	d.SetId(aws.StringValue(out.PolicyId))
+
+	return resourceAwsDlmLifecyclePolicyRead(d, meta)
+}
+
+func resourceAwsDlmLifecyclePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	log.Printf("[DEBUG] Reading DLM Lifecycle Policy: %s", d.Id())
+	out, err := conn.GetLifecyclePolicy(&dlm.GetLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, dlm.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] DLM Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DLM Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	if out.Policy == nil {
+		log.Printf("[WARN] DLM Lifecycle Policy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("description", out.Policy.Description)
+	d.Set("execution_role_arn", out.Policy.ExecutionRoleArn)
+	d.Set("state", out.Policy.State)
+	if err := d.Set("policy_details", flattenDlmPolicyDetails(out.Policy.PolicyDetails)); err != nil {
+		return fmt.Errorf("error setting policy_details: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsDlmLifecyclePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	input := &dlm.UpdateLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	}
+
+	if d.HasChange("description") {
+		input.Description = aws.String(d.Get("description").(string))
+	}
+	if d.HasChange("execution_role_arn") {
+		input.ExecutionRoleArn = aws.String(d.Get("execution_role_arn").(string))
+	}
+	if d.HasChange("state") {
+		input.State = aws.String(d.Get("state").(string))
+	}
+	if d.HasChange("policy_details") {
+		input.PolicyDetails = expandDlmPolicyDetails(d.Get("policy_details").([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Updating DLM Lifecycle Policy: %s", input)
+	_, err := conn.UpdateLifecyclePolicy(input)
+	if err != nil {
+		return fmt.Errorf("error updating DLM Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsDlmLifecyclePolicyRead(d, meta)
+}
+
+func resourceAwsDlmLifecyclePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).dlmconn
+
+	log.Printf("[DEBUG] Deleting DLM Lifecycle Policy: %s", d.Id())
+	_, err := conn.DeleteLifecyclePolicy(&dlm.DeleteLifecyclePolicyInput{
+		PolicyId: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, dlm.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DLM Lifecycle Policy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandDlmPolicyDetails(cfg []interface{}) *dlm.PolicyDetails {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	return &dlm.PolicyDetails{
+		ResourceTypes: expandStringList(m["resource_types"].([]interface{})),
+		Schedules:     expandDlmSchedules(m["schedule"].([]interface{})),
+		TargetTags:    expandDlmTags(m["target_tags"].(map[string]interface{})),
+	}
+}
+
+func flattenDlmPolicyDetails(policyDetails *dlm.PolicyDetails) []map[string]interface{} {
+	if policyDetails == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"resource_types": flattenStringList(policyDetails.ResourceTypes),
+		"schedule":       flattenDlmSchedules(policyDetails.Schedules),
+		"target_tags":    flattenDlmTags(policyDetails.TargetTags),
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmSchedules(cfg []interface{}) []*dlm.Schedule {
+	schedules := make([]*dlm.Schedule, len(cfg))
+	for i, c := range cfg {
+		m := c.(map[string]interface{})
+		schedules[i] = &dlm.Schedule{
+			CreateRule: expandDlmCreateRule(m["create_rule"].([]interface{})),
+			Name:       aws.String(m["name"].(string)),
+			RetainRule: expandDlmRetainRule(m["retain_rule"].([]interface{})),
+		}
+		if v, ok := m["tags_to_add"].(map[string]interface{}); ok && len(v) > 0 {
+			schedules[i].TagsToAdd = expandDlmTags(v)
+		}
+	}
+
+	return schedules
+}
+
+func flattenDlmSchedules(schedules []*dlm.Schedule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(schedules))
+	for i, s := range schedules {
+		result[i] = map[string]interface{}{
+			"create_rule": flattenDlmCreateRule(s.CreateRule),
+			"name":        aws.StringValue(s.Name),
+			"retain_rule": flattenDlmRetainRule(s.RetainRule),
+			"tags_to_add": flattenDlmTags(s.TagsToAdd),
+		}
+	}
+
+	return result
+}
+
+func expandDlmCreateRule(cfg []interface{}) *dlm.CreateRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	createRule := &dlm.CreateRule{
+		Interval:     aws.Int64(int64(m["interval"].(int))),
+		IntervalUnit: aws.String(m["interval_unit"].(string)),
+	}
+	if v, ok := m["times"].([]interface{}); ok && len(v) > 0 {
+		createRule.Times = expandStringList(v)
+	}
+
+	return createRule
+}
+
+func flattenDlmCreateRule(createRule *dlm.CreateRule) []map[string]interface{} {
+	if createRule == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"interval":      aws.Int64Value(createRule.Interval),
+		"interval_unit": aws.StringValue(createRule.IntervalUnit),
+		"times":         flattenStringList(createRule.Times),
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmRetainRule(cfg []interface{}) *dlm.RetainRule {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+
+	m := cfg[0].(map[string]interface{})
+	return &dlm.RetainRule{
+		Count: aws.Int64(int64(m["count"].(int))),
+	}
+}
+
+func flattenDlmRetainRule(retainRule *dlm.RetainRule) []map[string]interface{} {
+	if retainRule == nil {
+		return []map[string]interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"count": aws.Int64Value(retainRule.Count),
+	}
+
+	return []map[string]interface{}{result}
+}
+
+func expandDlmTags(m map[string]interface{}) []*dlm.Tag {
+	tags := make([]*dlm.Tag, 0, len(m))
+	for k, v := range m {
+		tags = append(tags, &dlm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	return tags
+}
+
+func flattenDlmTags(tags []*dlm.Tag) map[string]string {
+	result := make(map[string]string, len(tags))
+	for _, t := range tags {
+		result[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return result
+}
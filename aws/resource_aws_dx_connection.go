@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/directconnect"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -104,13 +103,7 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 		return nil
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "directconnect",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("dxcon/%s", d.Id()),
-	}.String()
+	arn := meta.(*AWSClient).regionalArn("directconnect", fmt.Sprintf("dxcon/%s", d.Id()))
 	d.Set("arn", arn)
 	d.Set("name", connection.ConnectionName)
 	d.Set("bandwidth", connection.Bandwidth)
@@ -127,13 +120,7 @@ func resourceAwsDxConnectionRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsDxConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).dxconn
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "directconnect",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("dxcon/%s", d.Id()),
-	}.String()
+	arn := meta.(*AWSClient).regionalArn("directconnect", fmt.Sprintf("dxcon/%s", d.Id()))
 	if err := setTagsDX(conn, d, arn); err != nil {
 		return err
 	}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 
@@ -138,7 +137,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(*result.VolumeId)
 
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return fmt.Errorf("Error setting tags for EBS Volume: %s", err)
 		}
@@ -149,7 +148,7 @@ func resourceAwsEbsVolumeCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceAWSEbsVolumeUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).ec2conn
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if err := setTags(conn, d); err != nil {
 			return fmt.Errorf("Error updating tags for EBS Volume: %s", err)
 		}
@@ -274,14 +273,8 @@ func resourceAwsEbsVolumeDelete(d *schema.ResourceData, meta interface{}) error
 func readVolume(d *schema.ResourceData, client *AWSClient, volume *ec2.Volume) error {
 	d.SetId(*volume.VolumeId)
 
-	arn := arn.ARN{
-		Partition: client.partition,
-		Region:    client.region,
-		Service:   "ec2",
-		AccountID: client.accountid,
-		Resource:  fmt.Sprintf("volume/%s", d.Id()),
-	}
-	d.Set("arn", arn.String())
+	arn := client.regionalArn("ec2", fmt.Sprintf("volume/%s", d.Id()))
+	d.Set("arn", arn)
 
 	d.Set("availability_zone", *volume.AvailabilityZone)
 	if volume.Encrypted != nil {
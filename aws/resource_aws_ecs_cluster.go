@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -39,13 +38,7 @@ func resourceAwsEcsCluster() *schema.Resource {
 
 func resourceAwsEcsClusterImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("name", d.Id())
-	d.SetId(arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Service:   "ecs",
-		Resource:  fmt.Sprintf("cluster/%s", d.Id()),
-	}.String())
+	d.SetId(meta.(*AWSClient).regionalArn("ecs", fmt.Sprintf("cluster/%s", d.Id())))
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -313,13 +312,7 @@ func resourceAwsEcsServiceImport(d *schema.ResourceData, meta interface{}) ([]*s
 	log.Printf("[DEBUG] Importing ECS service %s from cluster %s", name, cluster)
 
 	d.SetId(name)
-	clusterArn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "ecs",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("cluster/%s", cluster),
-	}.String()
+	clusterArn := meta.(*AWSClient).regionalArn("ecs", fmt.Sprintf("cluster/%s", cluster))
 	d.Set("cluster", clusterArn)
 	return []*schema.ResourceData{d}, nil
 }
@@ -524,10 +517,13 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", service.ServiceName)
 
 	// Save task definition in the same format
-	if strings.HasPrefix(d.Get("task_definition").(string), "arn:"+meta.(*AWSClient).partition+":ecs:") {
+	if isArnOfService(d.Get("task_definition").(string), meta.(*AWSClient).partition, "ecs") {
 		d.Set("task_definition", service.TaskDefinition)
 	} else {
-		taskDefinition := buildFamilyAndRevisionFromARN(*service.TaskDefinition)
+		taskDefinition, err := arnResourceName(*service.TaskDefinition)
+		if err != nil {
+			return err
+		}
 		d.Set("task_definition", taskDefinition)
 	}
 
@@ -537,20 +533,26 @@ func resourceAwsEcsServiceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("launch_type", service.LaunchType)
 
 	// Save cluster in the same format
-	if strings.HasPrefix(d.Get("cluster").(string), "arn:"+meta.(*AWSClient).partition+":ecs:") {
+	if isArnOfService(d.Get("cluster").(string), meta.(*AWSClient).partition, "ecs") {
 		d.Set("cluster", service.ClusterArn)
 	} else {
-		clusterARN := getNameFromARN(*service.ClusterArn)
-		d.Set("cluster", clusterARN)
+		clusterName, err := arnResourceName(*service.ClusterArn)
+		if err != nil {
+			return err
+		}
+		d.Set("cluster", clusterName)
 	}
 
 	// Save IAM role in the same format
 	if service.RoleArn != nil {
-		if strings.HasPrefix(d.Get("iam_role").(string), "arn:"+meta.(*AWSClient).partition+":iam:") {
+		if isArnOfService(d.Get("iam_role").(string), meta.(*AWSClient).partition, "iam") {
 			d.Set("iam_role", service.RoleArn)
 		} else {
-			roleARN := getNameFromARN(*service.RoleArn)
-			d.Set("iam_role", roleARN)
+			roleName, err := arnResourceName(*service.RoleArn)
+			if err != nil {
+				return err
+			}
+			d.Set("iam_role", roleName)
 		}
 	}
 
@@ -921,17 +923,6 @@ func resourceAwsEcsLoadBalancerHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func buildFamilyAndRevisionFromARN(arn string) string {
-	return strings.Split(arn, "/")[1]
-}
-
-// Expects the following ARNs:
-// arn:aws:iam::0123456789:role/EcsService
-// arn:aws:ecs:us-west-2:0123456789:cluster/radek-cluster
-func getNameFromARN(arn string) string {
-	return strings.Split(arn, "/")[1]
-}
-
 func parseTaskDefinition(taskDefinition string) (string, string, error) {
 	matches := taskDefinitionRE.FindAllStringSubmatch(taskDefinition, 2)
 
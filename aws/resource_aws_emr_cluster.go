@@ -26,6 +26,9 @@ func resourceAwsEMRCluster() *schema.Resource {
 		Read:   resourceAwsEMRClusterRead,
 		Update: resourceAwsEMRClusterUpdate,
 		Delete: resourceAwsEMRClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -42,6 +45,7 @@ func resourceAwsEMRCluster() *schema.Resource {
 				Required: false,
 				Optional: true,
 				ForceNew: true,
+				Computed: true,
 			},
 			"additional_info": {
 				Type:             schema.TypeString,
@@ -63,6 +67,7 @@ func resourceAwsEMRCluster() *schema.Resource {
 			"core_instance_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
+				Computed: true,
 			},
 			"cluster_state": {
 				Type:     schema.TypeString,
@@ -547,7 +552,7 @@ func resourceAwsEMRClusterCreate(d *schema.ResourceData, meta interface{}) error
 		steps := v.([]interface{})
 		params.Steps = expandEmrStepConfigs(steps)
 	}
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tagsIn := v.(map[string]interface{})
 		params.Tags = expandTags(tagsIn)
 	}
@@ -655,11 +660,21 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	instanceGroups, err := fetchAllEMRInstanceGroups(emrconn, d.Id())
 	if err == nil {
-		coreGroup := findGroup(instanceGroups, "CORE")
+		masterGroup := findGroup(instanceGroups, emr.InstanceRoleTypeMaster)
+		if masterGroup != nil {
+			d.Set("master_instance_type", masterGroup.InstanceType)
+		}
+		coreGroup := findGroup(instanceGroups, emr.InstanceRoleTypeCore)
 		if coreGroup != nil {
 			d.Set("core_instance_type", coreGroup.InstanceType)
+			// core_instance_count counts the master instance too
+			d.Set("core_instance_count", aws.Int64Value(coreGroup.RequestedInstanceCount)+1)
+		}
+		flattenedInstanceGroups, err := flattenInstanceGroups(instanceGroups)
+		if err != nil {
+			return fmt.Errorf("error flattening EMR instance groups for cluster (%s): %s", d.Id(), err)
 		}
-		if err := d.Set("instance_group", flattenInstanceGroups(instanceGroups)); err != nil {
+		if err := d.Set("instance_group", flattenedInstanceGroups); err != nil {
 			log.Printf("[ERR] Error setting EMR instance groups: %s", err)
 		}
 	}
@@ -676,6 +691,8 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("tags", tagsToMapEMR(cluster.Tags))
 	d.Set("ebs_root_volume_size", cluster.EbsRootVolumeSize)
 	d.Set("scale_down_behavior", cluster.ScaleDownBehavior)
+	d.Set("termination_protection", cluster.TerminationProtected)
+	d.Set("keep_job_flow_alive_when_no_steps", !aws.BoolValue(cluster.AutoTerminate))
 
 	if cluster.CustomAmiId != nil {
 		d.Set("custom_ami_id", cluster.CustomAmiId)
@@ -685,13 +702,12 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[ERR] Error setting EMR Applications for cluster (%s): %s", d.Id(), err)
 	}
 
-	// Configurations is a JSON document. It's built with an expand method but a
-	// simple string should be returned as JSON
-	if err := d.Set("configurations", cluster.Configurations); err != nil {
-		log.Printf("[ERR] Error setting EMR configurations for cluster (%s): %s", d.Id(), err)
-	}
-
-	if _, ok := d.GetOk("configurations_json"); ok {
+	// configurations is the location of a JSON document, which cannot be read
+	// back, so the configurations are read into configurations_json unless
+	// configurations is used, e.g. when importing.
+	_, configurationsJsonOk := d.GetOk("configurations_json")
+	_, configurationsOk := d.GetOk("configurations")
+	if configurationsJsonOk || (!configurationsOk && len(cluster.Configurations) > 0) {
 		configOut, err := flattenConfigurationJson(cluster.Configurations)
 		if err != nil {
 			return fmt.Errorf("Error reading EMR cluster configurations: %s", err)
@@ -709,15 +725,19 @@ func resourceAwsEMRClusterRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting kerberos_attributes: %s", err)
 	}
 
-	respBootstraps, err := emrconn.ListBootstrapActions(&emr.ListBootstrapActionsInput{
+	var bootstrapActions []*emr.Command
+	err = emrconn.ListBootstrapActionsPages(&emr.ListBootstrapActionsInput{
 		ClusterId: cluster.Id,
+	}, func(page *emr.ListBootstrapActionsOutput, lastPage bool) bool {
+		bootstrapActions = append(bootstrapActions, page.BootstrapActions...)
+		return !lastPage
 	})
 	if err != nil {
-		log.Printf("[WARN] Error listing bootstrap actions: %s", err)
+		return fmt.Errorf("error listing bootstrap actions for EMR cluster (%s): %s", d.Id(), err)
 	}
 
-	if err := d.Set("bootstrap_action", flattenBootstrapArguments(respBootstraps.BootstrapActions)); err != nil {
-		log.Printf("[WARN] Error setting Bootstrap Actions: %s", err)
+	if err := d.Set("bootstrap_action", flattenBootstrapArguments(bootstrapActions)); err != nil {
+		return fmt.Errorf("error setting bootstrap_action: %s", err)
 	}
 
 	var stepSummaries []*emr.StepSummary
@@ -1037,7 +1057,7 @@ func flattenEmrStepSummary(stepSummary *emr.StepSummary) map[string]interface{}
 	return m
 }
 
-func flattenInstanceGroups(igs []*emr.InstanceGroup) []map[string]interface{} {
+func flattenInstanceGroups(igs []*emr.InstanceGroup) ([]map[string]interface{}, error) {
 	result := make([]map[string]interface{}, 0)
 
 	for _, ig := range igs {
@@ -1047,38 +1067,75 @@ func flattenInstanceGroups(igs []*emr.InstanceGroup) []map[string]interface{} {
 		} else {
 			attrs["bid_price"] = ""
 		}
-		ebsConfig := make([]map[string]interface{}, 0)
-		for _, ebs := range ig.EbsBlockDevices {
-			ebsAttrs := make(map[string]interface{})
-			if ebs.VolumeSpecification.Iops != nil {
-				ebsAttrs["iops"] = *ebs.VolumeSpecification.Iops
-			} else {
-				ebsAttrs["iops"] = ""
-			}
-			ebsAttrs["size"] = *ebs.VolumeSpecification.SizeInGB
-			ebsAttrs["type"] = *ebs.VolumeSpecification.VolumeType
-			ebsAttrs["volumes_per_instance"] = 1
-
-			ebsConfig = append(ebsConfig, ebsAttrs)
-		}
-		attrs["ebs_config"] = ebsConfig
+		attrs["ebs_config"] = flattenEmrEbsBlockDevices(ig.EbsBlockDevices)
 		attrs["instance_count"] = *ig.RequestedInstanceCount
 		attrs["instance_role"] = *ig.InstanceGroupType
 		attrs["instance_type"] = *ig.InstanceType
 
-		if ig.AutoScalingPolicy != nil {
-			attrs["autoscaling_policy"] = *ig.AutoScalingPolicy
-		} else {
-			attrs["autoscaling_policy"] = ""
+		autoscalingPolicy, err := flattenEmrAutoScalingPolicyDescription(ig.AutoScalingPolicy)
+		if err != nil {
+			return nil, err
 		}
+		attrs["autoscaling_policy"] = autoscalingPolicy
 
 		attrs["name"] = *ig.Name
 		result = append(result, attrs)
 	}
 
+	return result, nil
+}
+
+// flattenEmrEbsBlockDevices flattens the EBS volumes of an instance group into
+// ebs_config blocks. EMR lists each volume attached to an instance, so
+// identical volumes are counted into volumes_per_instance.
+func flattenEmrEbsBlockDevices(devices []*emr.EbsBlockDevice) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	configs := make(map[string]map[string]interface{})
+
+	for _, ebs := range devices {
+		spec := ebs.VolumeSpecification
+		if spec == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%d-%s-%d", aws.Int64Value(spec.SizeInGB), aws.StringValue(spec.VolumeType), aws.Int64Value(spec.Iops))
+		if ebsAttrs, ok := configs[key]; ok {
+			ebsAttrs["volumes_per_instance"] = ebsAttrs["volumes_per_instance"].(int) + 1
+			continue
+		}
+
+		ebsAttrs := map[string]interface{}{
+			"size":                 aws.Int64Value(spec.SizeInGB),
+			"type":                 aws.StringValue(spec.VolumeType),
+			"volumes_per_instance": 1,
+		}
+		if spec.Iops != nil {
+			ebsAttrs["iops"] = aws.Int64Value(spec.Iops)
+		}
+		configs[key] = ebsAttrs
+		result = append(result, ebsAttrs)
+	}
+
 	return result
 }
 
+// flattenEmrAutoScalingPolicyDescription returns the JSON document of the
+// autoscaling policy of an instance group, without its status.
+func flattenEmrAutoScalingPolicyDescription(policy *emr.AutoScalingPolicyDescription) (string, error) {
+	if policy == nil {
+		return "", nil
+	}
+
+	out, err := jsonutil.BuildJSON(&emr.AutoScalingPolicy{
+		Constraints: policy.Constraints,
+		Rules:       policy.Rules,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
 func flattenBootstrapArguments(actions []*emr.Command) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0)
 
@@ -1118,82 +1175,69 @@ func findGroup(grps []*emr.InstanceGroup, typ string) *emr.InstanceGroup {
 }
 
 func expandTags(m map[string]interface{}) []*emr.Tag {
-	var result []*emr.Tag
-	for k, v := range m {
-		result = append(result, &emr.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().EMRTags()
 }
 
 func tagsToMapEMR(ts []*emr.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
-}
-
-func diffTagsEMR(oldTags, newTags []*emr.Tag) ([]*emr.Tag, []*emr.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*emr.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return expandTags(create), remove
+	return keyValueTagsFromEMR(ts).IgnoreAws().Map()
 }
 
 func setTagsEMR(conn *emr.EMR, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsEMR(expandTags(o), expandTags(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, len(remove), len(remove))
-			for i, t := range remove {
-				k[i] = t.Key
-			}
+	return tagsServiceEMR(conn).UpdateResource(d, d.Id())
+}
 
-			_, err := conn.RemoveTags(&emr.RemoveTagsInput{
-				ResourceId: aws.String(d.Id()),
-				TagKeys:    k,
+// tagsServiceEMR returns the calls that manage the tags of an EMR cluster.
+func tagsServiceEMR(conn *emr.EMR) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.DescribeCluster(&emr.DescribeClusterInput{
+				ClusterId: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
+			if resp.Cluster == nil {
+				return keyValueTags{}, nil
+			}
+			return keyValueTagsFromEMR(resp.Cluster.Tags), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
 			_, err := conn.AddTags(&emr.AddTagsInput{
-				ResourceId: aws.String(d.Id()),
-				Tags:       create,
+				ResourceId: aws.String(identifier),
+				Tags:       tags.EMRTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.RemoveTags(&emr.RemoveTagsInput{
+				ResourceId: aws.String(identifier),
+				TagKeys:    aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
+}
 
-	return nil
+func keyValueTagsFromEMR(ts []*emr.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// EMRTags returns the tags as EMR tags.
+func (tags keyValueTags) EMRTags() []*emr.Tag {
+	result := make([]*emr.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &emr.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
 }
 
 func expandBootstrapActions(bootstrapActions []interface{}) []*emr.BootstrapActionConfig {
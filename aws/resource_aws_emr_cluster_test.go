@@ -31,6 +31,12 @@ func TestAccAWSEMRCluster_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_emr_cluster.tf-test-cluster", "step.#", "0"),
 				),
 			},
+			{
+				ResourceName:            "aws_emr_cluster.tf-test-cluster",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"configurations", "configurations_json"},
+			},
 		},
 	})
 }
@@ -71,6 +77,11 @@ func TestAccAWSEMRCluster_configurationsJson(t *testing.T) {
 						regexp.MustCompile("{\"JAVA_HOME\":\"/usr/lib/jvm/java-1.8.0\".+")),
 				),
 			},
+			{
+				ResourceName:      "aws_emr_cluster.tf-test-cluster",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -439,6 +450,43 @@ func TestAccAWSEMRCluster_custom_ami_id(t *testing.T) {
 	})
 }
 
+func TestFlattenEmrEbsBlockDevices(t *testing.T) {
+	volume := func(size int64, volumeType string, iops int64) *emr.EbsBlockDevice {
+		spec := &emr.VolumeSpecification{
+			SizeInGB:   aws.Int64(size),
+			VolumeType: aws.String(volumeType),
+		}
+		if iops > 0 {
+			spec.Iops = aws.Int64(iops)
+		}
+		return &emr.EbsBlockDevice{VolumeSpecification: spec}
+	}
+
+	devices := []*emr.EbsBlockDevice{
+		volume(40, "gp2", 0),
+		volume(100, "io1", 1000),
+		volume(40, "gp2", 0),
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"size":                 int64(40),
+			"type":                 "gp2",
+			"volumes_per_instance": 2,
+		},
+		{
+			"size":                 int64(100),
+			"type":                 "io1",
+			"iops":                 int64(1000),
+			"volumes_per_instance": 1,
+		},
+	}
+
+	if actual := flattenEmrEbsBlockDevices(devices); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+}
+
 func testAccCheck_bootstrap_order(cluster *emr.Cluster, argsInts, argsStrings []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"errors"
 	"log"
+	"strings"
 	"time"
 
 	"fmt"
@@ -21,6 +22,9 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 		Read:   resourceAwsEMRInstanceGroupRead,
 		Update: resourceAwsEMRInstanceGroupUpdate,
 		Delete: resourceAwsEMRInstanceGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsEMRInstanceGroupImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -77,6 +81,7 @@ func resourceAwsEMRInstanceGroup() *schema.Resource {
 						"volumes_per_instance": {
 							Type:     schema.TypeInt,
 							Optional: true,
+							Default:  1,
 						},
 					},
 				},
@@ -180,13 +185,30 @@ func resourceAwsEMRInstanceGroupRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("instance_count", group.RequestedInstanceCount)
 	d.Set("running_instance_count", group.RunningInstanceCount)
 	d.Set("instance_type", group.InstanceType)
+	d.Set("ebs_optimized", group.EbsOptimized)
 	if group.Status != nil && group.Status.State != nil {
 		d.Set("status", group.Status.State)
 	}
 
+	if err := d.Set("ebs_config", flattenEmrEbsBlockDevices(group.EbsBlockDevices)); err != nil {
+		return fmt.Errorf("error setting ebs_config: %s", err)
+	}
+
 	return nil
 }
 
+func resourceAwsEMRInstanceGroupImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <cluster-id>/<instance-group-id>", d.Id())
+	}
+
+	d.Set("cluster_id", idParts[0])
+	d.SetId(idParts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func fetchAllEMRInstanceGroups(conn *emr.EMR, clusterId string) ([]*emr.InstanceGroup, error) {
 	req := &emr.ListInstanceGroupsInput{
 		ClusterId: aws.String(clusterId),
@@ -212,6 +234,7 @@ func fetchAllEMRInstanceGroups(conn *emr.EMR, clusterId string) ([]*emr.Instance
 			log.Printf("[DEBUG] EMR Instance Group list was empty")
 		}
 		marker = respGrps.Marker
+		req.Marker = marker
 	}
 
 	if len(groups) == 0 {
@@ -25,6 +25,12 @@ func TestAccAWSEMRInstanceGroup_basic(t *testing.T) {
 				Config: testAccAWSEmrInstanceGroupConfig(rInt),
 				Check:  testAccCheckAWSEmrInstanceGroupExists("aws_emr_instance_group.task", &ig),
 			},
+			{
+				ResourceName:      "aws_emr_instance_group.task",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSEMRInstanceGroupImportStateIdFunc("aws_emr_instance_group.task"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -68,10 +74,27 @@ func TestAccAWSEMRInstanceGroup_ebsBasic(t *testing.T) {
 						"aws_emr_instance_group.task", "ebs_optimized", "true"),
 				),
 			},
+			{
+				ResourceName:      "aws_emr_instance_group.task",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSEMRInstanceGroupImportStateIdFunc("aws_emr_instance_group.task"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func testAccAWSEMRInstanceGroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["cluster_id"], rs.Primary.ID), nil
+	}
+}
+
 func testAccCheckAWSEmrInstanceGroupDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*AWSClient).emrconn
 
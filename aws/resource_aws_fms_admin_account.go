@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceAwsFmsAdminAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsFmsAdminAccountCreate,
+		Read:   resourceAwsFmsAdminAccountRead,
+		Delete: resourceAwsFmsAdminAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAwsAccountId,
+			},
+		},
+	}
+}
+
+func resourceAwsFmsAdminAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	accountID := meta.(*AWSClient).accountid
+	if v, ok := d.GetOk("account_id"); ok {
+		accountID = v.(string)
+	}
+
+	// Only one account can be the administrator of Firewall Manager
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+	if err != nil && !isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return fmt.Errorf("error reading FMS Admin Account: %s", err)
+	}
+	if output != nil && aws.StringValue(output.AdminAccount) != "" && aws.StringValue(output.RoleStatus) != fms.AccountRoleStatusDeleted {
+		return fmt.Errorf("error associating FMS Admin Account (%s): account %s is already the administrator", accountID, aws.StringValue(output.AdminAccount))
+	}
+
+	log.Printf("[DEBUG] Associating FMS Admin Account: %s", accountID)
+	_, err = conn.AssociateAdminAccount(&fms.AssociateAdminAccountInput{
+		AdminAccount: aws.String(accountID),
+	})
+	if err != nil {
+		return fmt.Errorf("error associating FMS Admin Account (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+
+	if _, err := fmsAdminAccountReadyWaiter.waitForResource(d, schema.TimeoutCreate, fmsAdminAccountStatus(conn)); err != nil {
+		return fmt.Errorf("error waiting for FMS Admin Account (%s) association: %s", d.Id(), err)
+	}
+
+	return resourceAwsFmsAdminAccountRead(d, meta)
+}
+
+func resourceAwsFmsAdminAccountRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading FMS Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != d.Id() || aws.StringValue(output.RoleStatus) == fms.AccountRoleStatusDeleted {
+		log.Printf("[WARN] FMS Admin Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("account_id", output.AdminAccount)
+
+	return nil
+}
+
+func resourceAwsFmsAdminAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).fmsconn
+
+	log.Printf("[DEBUG] Disassociating FMS Admin Account: %s", d.Id())
+	_, err := conn.DisassociateAdminAccount(&fms.DisassociateAdminAccountInput{})
+
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error disassociating FMS Admin Account (%s): %s", d.Id(), err)
+	}
+
+	if _, err := fmsAdminAccountDeletedWaiter.waitForResource(d, schema.TimeoutDelete, fmsAdminAccountStatus(conn)); err != nil {
+		return fmt.Errorf("error waiting for FMS Admin Account (%s) disassociation: %s", d.Id(), err)
+	}
+
+	return nil
+}
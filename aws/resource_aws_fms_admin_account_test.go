@@ -0,0 +1,98 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSFmsAdminAccount_basic(t *testing.T) {
+	resourceName := "aws_fms_admin_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccOrganizationsAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsAdminAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsAdminAccountConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsAdminAccountExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "aws_organizations_organization.test", "master_account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAWSFmsAdminAccountDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_admin_account" {
+			continue
+		}
+
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading FMS Admin Account (%s): %s", rs.Primary.ID, err)
+		}
+
+		if aws.StringValue(output.AdminAccount) == rs.Primary.ID && aws.StringValue(output.RoleStatus) != fms.AccountRoleStatusDeleted {
+			return fmt.Errorf("FMS Admin Account (%s) still associated", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFmsAdminAccountExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Admin Account ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+		if err != nil {
+			return err
+		}
+
+		if aws.StringValue(output.AdminAccount) != rs.Primary.ID {
+			return fmt.Errorf("FMS Admin Account is %s, expected %s", aws.StringValue(output.AdminAccount), rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+// The administrator of Firewall Manager must be the master account of an
+// organization with all features enabled
+const testAccAWSFmsAdminAccountConfig = `
+resource "aws_organizations_organization" "test" {
+  feature_set = "ALL"
+}
+
+resource "aws_fms_admin_account" "test" {
+  account_id = "${aws_organizations_organization.test.master_account_id}"
+}
+`
@@ -0,0 +1,221 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSFmsPolicy_basic(t *testing.T) {
+	var policy fms.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccFmsAdminAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "false"),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "resource_type", "AWS::ElasticLoadBalancingV2::LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", fms.SecurityServiceTypeWaf),
+					resource.TestCheckResourceAttr(resourceName, "exclude_map.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_update_token"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSFmsPolicyConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "remediation_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSFmsPolicy_includeMap(t *testing.T) {
+	var policy fms.Policy
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t); testAccFmsAdminAccountPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSFmsPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSFmsPolicyConfigIncludeMap(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSFmsPolicyExists(resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "include_map.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "include_map.0.account.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_resource_tags", "true"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_tags.Environment", "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccFmsAdminAccountPreCheck skips tests of FMS policies unless the
+// account running them is the administrator of Firewall Manager.
+func testAccFmsAdminAccountPreCheck(t *testing.T) {
+	client := testAccProvider.Meta().(*AWSClient)
+
+	output, err := client.fmsconn.GetAdminAccount(&fms.GetAdminAccountInput{})
+	if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+		t.Skip("skipping tests; this AWS account must be the FMS administrator account")
+	}
+	if err != nil {
+		t.Fatalf("error reading FMS Admin Account: %s", err)
+	}
+
+	if aws.StringValue(output.AdminAccount) != client.accountid || aws.StringValue(output.RoleStatus) != fms.AccountRoleStatusReady {
+		t.Skip("skipping tests; this AWS account must be the FMS administrator account")
+	}
+}
+
+func testAccCheckAWSFmsPolicyDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).fmsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_fms_policy" {
+			continue
+		}
+
+		output, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading FMS Policy (%s): %s", rs.Primary.ID, err)
+		}
+
+		if output != nil && output.Policy != nil {
+			return fmt.Errorf("FMS Policy (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSFmsPolicyExists(n string, policy *fms.Policy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No FMS Policy ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).fmsconn
+		output, err := conn.GetPolicy(&fms.GetPolicyInput{
+			PolicyId: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*policy = *output.Policy
+
+		return nil
+	}
+}
+
+func testAccAWSFmsPolicyConfigRuleGroup(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafregional_rule_group" "test" {
+  metric_name = "MyTest"
+  name        = %[1]q
+}
+`, rName)
+}
+
+func testAccAWSFmsPolicyConfig(rName string, remediationEnabled bool) string {
+	return testAccAWSFmsPolicyConfigRuleGroup(rName) + fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = false
+  remediation_enabled   = %[2]t
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = <<EOF
+{
+  "type": "WAF",
+  "ruleGroups": [{"id": "${aws_wafregional_rule_group.test.id}", "overrideAction": {"type": "COUNT"}}],
+  "defaultAction": {"type": "BLOCK"},
+  "overrideCustomerWebACLAssociation": false
+}
+EOF
+  }
+}
+`, rName, remediationEnabled)
+}
+
+func testAccAWSFmsPolicyConfigIncludeMap(rName string) string {
+	return testAccAWSFmsPolicyConfigRuleGroup(rName) + fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fms_policy" "test" {
+  name                  = %[1]q
+  exclude_resource_tags = true
+  resource_type         = "AWS::ElasticLoadBalancingV2::LoadBalancer"
+
+  include_map {
+    account = ["${data.aws_caller_identity.current.account_id}"]
+  }
+
+  resource_tags {
+    Environment = "test"
+  }
+
+  security_service_policy_data {
+    type = "WAF"
+
+    managed_service_data = <<EOF
+{
+  "type": "WAF",
+  "ruleGroups": [{"id": "${aws_wafregional_rule_group.test.id}", "overrideAction": {"type": "COUNT"}}],
+  "defaultAction": {"type": "BLOCK"},
+  "overrideCustomerWebACLAssociation": false
+}
+EOF
+  }
+}
+`, rName)
+}
@@ -18,6 +18,10 @@ func resourceAwsGameliftBuild() *schema.Resource {
 		Update: resourceAwsGameliftBuildUpdate,
 		Delete: resourceAwsGameliftBuildDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -34,27 +38,31 @@ func resourceAwsGameliftBuild() *schema.Resource {
 				}, false),
 			},
 			"storage_location": {
-				Type:     schema.TypeList,
-				Required: true,
-				ForceNew: true,
-				MaxItems: 1,
+				Type:             schema.TypeList,
+				Required:         true,
+				ForceNew:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: suppressGameliftBuildImportedStorageLocation,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"bucket": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressGameliftBuildImportedStorageLocation,
 						},
 						"key": {
-							Type:     schema.TypeString,
-							Required: true,
-							ForceNew: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppressGameliftBuildImportedStorageLocation,
 						},
 						"role_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: validateArn,
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     validateArn,
+							DiffSuppressFunc: suppressGameliftBuildImportedStorageLocation,
 						},
 					},
 				},
@@ -188,3 +196,10 @@ func expandGameliftStorageLocation(cfg []interface{}) *gamelift.S3Location {
 		RoleArn: aws.String(loc["role_arn"].(string)),
 	}
 }
+
+// suppressGameliftBuildImportedStorageLocation suppresses the diff of the
+// storage location of imported builds, which cannot be read back from Gamelift
+// and so is missing from their state, so that they are not replaced.
+func suppressGameliftBuildImportedStorageLocation(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && (old == "" || (k == "storage_location.#" && old == "0"))
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -99,6 +100,12 @@ func TestAccAWSGameliftBuild_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_gamelift_build.test", "storage_location.0.role_arn", roleArn),
 				),
 			},
+			{
+				ResourceName:            "aws_gamelift_build.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"storage_location"},
+			},
 			{
 				Config: testAccAWSGameliftBuildBasicConfig(uBuildName, bucketName, key, roleArn),
 				Check: resource.ComposeTestCheckFunc(
@@ -115,6 +122,72 @@ func TestAccAWSGameliftBuild_basic(t *testing.T) {
 	})
 }
 
+func TestResourceAwsGameliftBuildDiff_importedStorageLocation(t *testing.T) {
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"name":             "test",
+		"operating_system": gamelift.OperatingSystemAmazonLinux,
+		"storage_location": []interface{}{
+			map[string]interface{}{
+				"bucket":   "bucket",
+				"key":      "build.zip",
+				"role_arn": "arn:aws:iam::123456789012:role/gamelift",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error parsing config: %s", err)
+	}
+	c := terraform.NewResourceConfig(raw)
+	r := resourceAwsGameliftBuild()
+
+	// A new build is created with its storage location
+	diff, err := r.Diff(nil, c, nil)
+	if err != nil {
+		t.Fatalf("error planning create: %s", err)
+	}
+	if attr, ok := diff.Attributes["storage_location.0.bucket"]; !ok || attr.New != "bucket" {
+		t.Fatalf("expected the storage location to be created, got: %#v", diff)
+	}
+
+	// An imported build, whose storage location is not in its state, is kept
+	imported := &terraform.InstanceState{
+		ID: "build-12345678",
+		Attributes: map[string]string{
+			"id":               "build-12345678",
+			"name":             "test",
+			"operating_system": gamelift.OperatingSystemAmazonLinux,
+		},
+	}
+	diff, err = r.Diff(imported, c, nil)
+	if err != nil {
+		t.Fatalf("error planning imported build: %s", err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected the imported build not to be replaced, got: %#v", diff)
+	}
+
+	// A change of the storage location of a created build replaces it
+	created := &terraform.InstanceState{
+		ID: "build-12345678",
+		Attributes: map[string]string{
+			"id":                          "build-12345678",
+			"name":                        "test",
+			"operating_system":            gamelift.OperatingSystemAmazonLinux,
+			"storage_location.#":          "1",
+			"storage_location.0.bucket":   "other-bucket",
+			"storage_location.0.key":      "build.zip",
+			"storage_location.0.role_arn": "arn:aws:iam::123456789012:role/gamelift",
+		},
+	}
+	diff, err = r.Diff(created, c, nil)
+	if err != nil {
+		t.Fatalf("error planning created build: %s", err)
+	}
+	if !diff.RequiresNew() {
+		t.Fatalf("expected the build to be replaced, got: %#v", diff)
+	}
+}
+
 func testAccCheckAWSGameliftBuildExists(n string, res *gamelift.Build) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
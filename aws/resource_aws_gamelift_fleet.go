@@ -20,6 +20,10 @@ func resourceAwsGameliftFleet() *schema.Resource {
 		Update: resourceAwsGameliftFleetUpdate,
 		Delete: resourceAwsGameliftFleetDelete,
 
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(70 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
@@ -283,6 +287,7 @@ func resourceAwsGameliftFleetRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("build_id", fleet.BuildId)
 	d.Set("description", fleet.Description)
 	d.Set("arn", fleet.FleetArn)
+	d.Set("ec2_instance_type", fleet.InstanceType)
 	d.Set("log_paths", aws.StringValueSlice(fleet.LogPaths))
 	d.Set("metric_groups", flattenStringList(fleet.MetricGroups))
 	d.Set("name", fleet.Name)
@@ -290,6 +295,26 @@ func resourceAwsGameliftFleetRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("operating_system", fleet.OperatingSystem)
 	d.Set("resource_creation_limit_policy", flattenGameliftResourceCreationLimitPolicy(fleet.ResourceCreationLimitPolicy))
 
+	portSettings, err := conn.DescribeFleetPortSettings(&gamelift.DescribeFleetPortSettingsInput{
+		FleetId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Gamelift Fleet (%s) port settings: %s", d.Id(), err)
+	}
+	if err := d.Set("ec2_inbound_permission", flattenGameliftIpPermissions(portSettings.InboundPermissions)); err != nil {
+		return fmt.Errorf("error setting ec2_inbound_permission: %s", err)
+	}
+
+	runtimeConfiguration, err := conn.DescribeRuntimeConfiguration(&gamelift.DescribeRuntimeConfigurationInput{
+		FleetId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing Gamelift Fleet (%s) runtime configuration: %s", d.Id(), err)
+	}
+	if err := d.Set("runtime_configuration", flattenGameliftRuntimeConfiguration(runtimeConfiguration.RuntimeConfiguration)); err != nil {
+		return fmt.Errorf("error setting runtime_configuration: %s", err)
+	}
+
 	return nil
 }
 
@@ -496,6 +521,41 @@ func expandGameliftRuntimeConfiguration(cfg []interface{}) *gamelift.RuntimeConf
 	return &out
 }
 
+// flattenGameliftRuntimeConfiguration returns no runtime_configuration for
+// fleets without server processes, which were created with a launch path.
+func flattenGameliftRuntimeConfiguration(cfg *gamelift.RuntimeConfiguration) []interface{} {
+	if cfg == nil || len(cfg.ServerProcesses) == 0 {
+		return []interface{}{}
+	}
+
+	m := make(map[string]interface{}, 0)
+	if cfg.GameSessionActivationTimeoutSeconds != nil {
+		m["game_session_activation_timeout_seconds"] = *cfg.GameSessionActivationTimeoutSeconds
+	}
+	if cfg.MaxConcurrentGameSessionActivations != nil {
+		m["max_concurrent_game_session_activations"] = *cfg.MaxConcurrentGameSessionActivations
+	}
+	m["server_process"] = flattenGameliftServerProcesses(cfg.ServerProcesses)
+
+	return []interface{}{m}
+}
+
+func flattenGameliftServerProcesses(processes []*gamelift.ServerProcess) []interface{} {
+	out := make([]interface{}, len(processes), len(processes))
+
+	for i, process := range processes {
+		m := make(map[string]interface{}, 0)
+		m["concurrent_executions"] = *process.ConcurrentExecutions
+		m["launch_path"] = *process.LaunchPath
+		if process.Parameters != nil {
+			m["parameters"] = *process.Parameters
+		}
+		out[i] = m
+	}
+
+	return out
+}
+
 func expandGameliftServerProcesses(cfgs []interface{}) []*gamelift.ServerProcess {
 	if len(cfgs) < 1 {
 		return []*gamelift.ServerProcess{}
@@ -538,7 +598,7 @@ func _getGameliftFleetFailures(conn *gamelift.GameLift, id string, nextToken *st
 	}
 
 	if eOut.NextToken != nil {
-		err := _getGameliftFleetFailures(conn, id, nextToken, events)
+		err := _getGameliftFleetFailures(conn, id, eOut.NextToken, events)
 		if err != nil {
 			return err
 		}
@@ -19,6 +19,9 @@ func resourceAwsIamAccessKey() *schema.Resource {
 		Create: resourceAwsIamAccessKeyCreate,
 		Read:   resourceAwsIamAccessKeyRead,
 		Delete: resourceAwsIamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -147,6 +150,28 @@ func resourceAwsIamAccessKeyReadResult(d *schema.ResourceData, key *iam.AccessKe
 	return nil
 }
 
+// resourceAwsIamAccessKeyImport imports an access key by its ID, looking up
+// the user it belongs to. The secret can only be retrieved when the key is
+// created, so secret, ses_smtp_password, encrypted_secret and key_fingerprint
+// are left unset.
+func resourceAwsIamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	resp, err := iamconn.GetAccessKeyLastUsed(&iam.GetAccessKeyLastUsedInput{
+		AccessKeyId: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM access key (%s): %s", d.Id(), err)
+	}
+	if resp.UserName == nil {
+		return nil, fmt.Errorf("Error reading IAM access key (%s): user not found", d.Id())
+	}
+
+	d.Set("user", resp.UserName)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	iamconn := meta.(*AWSClient).iamconn
 
@@ -15,6 +15,9 @@ func resourceAwsIamGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamGroupMembershipRead,
 		Update: resourceAwsIamGroupMembershipUpdate,
 		Delete: resourceAwsIamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -137,6 +140,15 @@ func resourceAwsIamGroupMembershipDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceAwsIamGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The membership name only exists in Terraform, so it is set to the group
+	// name, which is also the ID of the membership.
+	d.Set("name", d.Id())
+	d.Set("group", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func removeUsersFromGroup(conn *iam.IAM, users []*string, group string) error {
 	for _, u := range users {
 		_, err := conn.RemoveUserFromGroup(&iam.RemoveUserFromGroupInput{
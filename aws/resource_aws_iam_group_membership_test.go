@@ -35,6 +35,29 @@ func TestAccAWSGroupMembership_basic(t *testing.T) {
 					testAccCheckAWSGroupMembershipAttributes(&group, groupName, []string{userName}),
 				),
 			},
+			{
+				ResourceName:  "aws_iam_group_membership.team",
+				ImportState:   true,
+				ImportStateId: groupName,
+				// The membership name is set to the group name on import
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					if rs.Attributes["name"] != groupName || rs.Attributes["group"] != groupName {
+						return fmt.Errorf("expected name and group attributes to be %q, received: %q and %q", groupName, rs.Attributes["name"], rs.Attributes["group"])
+					}
+
+					if rs.Attributes["users.#"] != "1" {
+						return fmt.Errorf("expected 1 user, received: %s", rs.Attributes["users.#"])
+					}
+
+					return nil
+				},
+			},
 
 			{
 				Config: testAccAWSGroupMemberConfigUpdate(groupName, userName, userName2, userName3, membershipName),
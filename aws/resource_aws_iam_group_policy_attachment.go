@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -16,6 +17,9 @@ func resourceAwsIamGroupPolicyAttachment() *schema.Resource {
 		Create: resourceAwsIamGroupPolicyAttachmentCreate,
 		Read:   resourceAwsIamGroupPolicyAttachmentRead,
 		Delete: resourceAwsIamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamGroupPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group": {
@@ -67,18 +71,21 @@ func resourceAwsIamGroupPolicyAttachmentRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	attachedPolicies, err := conn.ListAttachedGroupPolicies(&iam.ListAttachedGroupPoliciesInput{
+	args := iam.ListAttachedGroupPoliciesInput{
 		GroupName: aws.String(group),
-	})
-	if err != nil {
-		return err
 	}
-
 	var policy string
-	for _, p := range attachedPolicies.AttachedPolicies {
-		if *p.PolicyArn == arn {
-			policy = *p.PolicyArn
+	err = conn.ListAttachedGroupPoliciesPages(&args, func(page *iam.ListAttachedGroupPoliciesOutput, lastPage bool) bool {
+		for _, p := range page.AttachedPolicies {
+			if *p.PolicyArn == arn {
+				policy = *p.PolicyArn
+			}
 		}
+
+		return policy == ""
+	})
+	if err != nil {
+		return err
 	}
 
 	if policy == "" {
@@ -101,6 +108,22 @@ func resourceAwsIamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceAwsIamGroupPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected <group-name>/<policy_arn>", d.Id())
+	}
+
+	groupName := idParts[0]
+	policyARN := idParts[1]
+
+	d.Set("group", groupName)
+	d.Set("policy_arn", policyARN)
+	d.SetId(fmt.Sprintf("%s-%s", groupName, policyARN))
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func attachPolicyToGroup(conn *iam.IAM, group string, arn string) error {
 	_, err := conn.AttachGroupPolicy(&iam.AttachGroupPolicyInput{
 		GroupName: aws.String(group),
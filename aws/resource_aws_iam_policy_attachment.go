@@ -20,6 +20,9 @@ func resourceAwsIamPolicyAttachment() *schema.Resource {
 		Read:   resourceAwsIamPolicyAttachmentRead,
 		Update: resourceAwsIamPolicyAttachmentUpdate,
 		Delete: resourceAwsIamPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamPolicyAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -140,6 +143,22 @@ func resourceAwsIamPolicyAttachmentRead(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+func resourceAwsIamPolicyAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Policy ARNs contain slashes, so the ID is split before the ARN
+	i := strings.Index(d.Id(), "/arn:")
+	if i < 1 {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <name>/<policy_arn>", d.Id())
+	}
+
+	name := d.Id()[:i]
+	d.Set("name", name)
+	d.Set("policy_arn", d.Id()[i+1:])
+	d.SetId(name)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsIamPolicyAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).iamconn
 	name := d.Get("name").(string)
@@ -15,6 +15,41 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestAWSIAMRole_offline(t *testing.T) {
+	f := newFakeAWS(t, "iam_role")
+	defer f.Close()
+
+	assumeRolePolicy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`
+
+	testFakeAWSResource(t, f, "aws_iam_role",
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"name":               "tf-acc-fake-role",
+				"assume_role_policy": assumeRolePolicy,
+			},
+			Check: map[string]string{
+				"id":                   "tf-acc-fake-role",
+				"arn":                  "arn:aws:iam::123456789012:role/tf-acc-fake-role",
+				"path":                 "/",
+				"unique_id":            "AROAJ52OTH4H7LEXAMPLE",
+				"create_date":          "2018-10-16T13:20:00Z",
+				"max_session_duration": "3600",
+				"description":          "",
+			},
+		},
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"name":               "tf-acc-fake-role",
+				"assume_role_policy": assumeRolePolicy,
+				"description":        "Updated by Terraform",
+			},
+			Check: map[string]string{
+				"description": "Updated by Terraform",
+			},
+		},
+	)
+}
+
 func TestAccAWSIAMRole_importBasic(t *testing.T) {
 	resourceName := "aws_iam_role.role"
 	rName := acctest.RandString(10)
@@ -3,7 +3,6 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -121,10 +120,9 @@ func resourceAwsIamSamlProviderDelete(d *schema.ResourceData, meta interface{})
 
 func extractNameFromIAMSamlProviderArn(arn, partition string) (string, error) {
 	// arn:aws:iam::123456789012:saml-provider/tf-salesforce-test
-	r := regexp.MustCompile(fmt.Sprintf("^arn:%s:iam::[0-9]{12}:saml-provider/(.+)$", partition))
-	submatches := r.FindStringSubmatch(arn)
-	if len(submatches) != 2 {
+	a, resourceType, name, err := parseArnResource(arn)
+	if err != nil || a.Partition != partition || a.Service != "iam" || resourceType != "saml-provider" || name == "" {
 		return "", fmt.Errorf("Unable to extract name from a given ARN: %q", arn)
 	}
-	return submatches[1], nil
+	return name, nil
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/iam"
 
@@ -16,6 +17,9 @@ func resourceAwsIamUserGroupMembership() *schema.Resource {
 		Read:   resourceAwsIamUserGroupMembershipRead,
 		Update: resourceAwsIamUserGroupMembershipUpdate,
 		Delete: resourceAwsIamUserGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserGroupMembershipImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -165,3 +169,24 @@ func addUserToGroups(conn *iam.IAM, user string, groups []*string) error {
 
 	return nil
 }
+
+func resourceAwsIamUserGroupMembershipImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "/")
+	if len(idParts) < 2 || idParts[0] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <user-name>/<group-name1>/...", d.Id())
+	}
+
+	var groups []string
+	for _, group := range idParts[1:] {
+		if group == "" {
+			return nil, fmt.Errorf("Unexpected format of ID (%q), expected <user-name>/<group-name1>/...", d.Id())
+		}
+		groups = append(groups, group)
+	}
+
+	d.Set("user", idParts[0])
+	d.Set("groups", groups)
+	d.SetId(resource.UniqueId())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,6 +2,8 @@ package aws
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -35,6 +37,29 @@ func TestAccAWSUserGroupMembership_basic(t *testing.T) {
 					testAccAWSUserGroupMembershipCheckGroupListForUser(userName1, []string{groupName1}, []string{groupName2, groupName3}),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_user_group_membership.user1_test1",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSUserGroupMembershipImportStateIdFunc("aws_iam_user_group_membership.user1_test1"),
+				// The ID is a resource.UniqueId(), so it is generated again on import
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					if rs.Attributes["user"] != userName1 {
+						return fmt.Errorf("expected user attribute to be %q, received: %q", userName1, rs.Attributes["user"])
+					}
+
+					if rs.Attributes["groups.#"] != "1" {
+						return fmt.Errorf("expected 1 group, received: %s", rs.Attributes["groups.#"])
+					}
+
+					return nil
+				},
+			},
 			// test adding an additional group to an existing resource
 			{
 				Config: usersAndGroupsConfig + testAccAWSUserGroupMembershipConfigAddOne,
@@ -124,6 +149,28 @@ func testAccAWSUserGroupMembershipDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccAWSUserGroupMembershipImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		groupCount, _ := strconv.Atoi(rs.Primary.Attributes["groups.#"])
+		parts := []string{rs.Primary.Attributes["user"]}
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "groups.") && k != "groups.#" {
+				parts = append(parts, v)
+			}
+		}
+		if len(parts) != groupCount+1 {
+			return "", fmt.Errorf("expected %d groups in %s", groupCount, resourceName)
+		}
+
+		return strings.Join(parts, "/"), nil
+	}
+}
+
 func testAccAWSUserGroupMembershipCheckGroupListForUser(userName string, groups []string, groupsNeg []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := testAccProvider.Meta().(*AWSClient).iamconn
@@ -18,9 +18,12 @@ import (
 func resourceAwsIamUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceAwsIamUserLoginProfileCreate,
-		Read:   schema.Noop,
+		Read:   resourceAwsIamUserLoginProfileRead,
 		Update: schema.Noop,
 		Delete: schema.RemoveFromState,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserLoginProfileImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user": {
@@ -173,3 +176,41 @@ func resourceAwsIamUserLoginProfileCreate(d *schema.ResourceData, meta interface
 	d.Set("encrypted_password", encrypted)
 	return nil
 }
+
+func resourceAwsIamUserLoginProfileRead(d *schema.ResourceData, meta interface{}) error {
+	iamconn := meta.(*AWSClient).iamconn
+
+	_, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
+		UserName: aws.String(d.Id()),
+	})
+	if isAWSErr(err, iam.ErrCodeNoSuchEntityException, "") {
+		log.Printf("[WARN] IAM User Login Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error reading IAM User Login Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("user", d.Id())
+	return nil
+}
+
+// resourceAwsIamUserLoginProfileImport imports the login profile of a user by
+// the user name. The password can't be retrieved, so encrypted_password and
+// key_fingerprint are left unset, as are the pgp_key and password_length
+// arguments used to create it.
+func resourceAwsIamUserLoginProfileImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	iamconn := meta.(*AWSClient).iamconn
+
+	resp, err := iamconn.GetLoginProfile(&iam.GetLoginProfileInput{
+		UserName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM User Login Profile (%s): %s", d.Id(), err)
+	}
+
+	d.Set("password_reset_required", resp.LoginProfile.PasswordResetRequired)
+
+	return []*schema.ResourceData{d}, nil
+}
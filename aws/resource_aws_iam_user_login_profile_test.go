@@ -72,6 +72,12 @@ func TestAccAWSUserLoginProfile_basic(t *testing.T) {
 					testDecryptPasswordAndTest("aws_iam_user_login_profile.user", "aws_iam_access_key.user", testPrivKey1),
 				),
 			},
+			{
+				ResourceName:            "aws_iam_user_login_profile.user",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"encrypted_password", "key_fingerprint", "password_length", "pgp_key"},
+			},
 		},
 	})
 }
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -18,6 +19,9 @@ func resourceAwsIamUserSshKey() *schema.Resource {
 		Read:   resourceAwsIamUserSshKeyRead,
 		Update: resourceAwsIamUserSshKeyUpdate,
 		Delete: resourceAwsIamUserSshKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsIamUserSshKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ssh_public_key_id": {
@@ -140,3 +144,31 @@ func resourceAwsIamUserSshKeyDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	return nil
 }
+
+func resourceAwsIamUserSshKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), ":")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <username>:<ssh-public-key-id>:<encoding>", d.Id())
+	}
+
+	username := idParts[0]
+	sshPublicKeyId := idParts[1]
+	encoding := idParts[2]
+
+	iamconn := meta.(*AWSClient).iamconn
+	getResp, err := iamconn.GetSSHPublicKey(&iam.GetSSHPublicKeyInput{
+		UserName:       aws.String(username),
+		SSHPublicKeyId: aws.String(sshPublicKeyId),
+		Encoding:       aws.String(encoding),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading IAM User SSH Key %s: %s", sshPublicKeyId, err)
+	}
+
+	d.Set("username", username)
+	d.Set("encoding", encoding)
+	d.Set("public_key", getResp.SSHPublicKey.SSHPublicKeyBody)
+	d.SetId(sshPublicKeyId)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -29,6 +29,14 @@ func TestAccAWSUserSSHKey_basic(t *testing.T) {
 					testAccCheckAWSUserSSHKeyExists("aws_iam_user_ssh_key.user", "Inactive", &conf),
 				),
 			},
+			{
+				ResourceName:      "aws_iam_user_ssh_key.user",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSUserSSHKeyImportStateIdFunc("aws_iam_user_ssh_key.user"),
+				ImportStateVerify: true,
+				// The public key body returned by IAM may be normalized
+				ImportStateVerifyIgnore: []string{"public_key"},
+			},
 		},
 	})
 }
@@ -54,6 +62,17 @@ func TestAccAWSUserSSHKey_pemEncoding(t *testing.T) {
 	})
 }
 
+func testAccAWSUserSSHKeyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s:%s", rs.Primary.Attributes["username"], rs.Primary.ID, rs.Primary.Attributes["encoding"]), nil
+	}
+}
+
 func testAccCheckAWSUserSSHKeyDestroy(s *terraform.State) error {
 	iamconn := testAccProvider.Meta().(*AWSClient).iamconn
 
@@ -0,0 +1,1374 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsKinesisAnalyticsApplication() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsKinesisAnalyticsApplicationCreate,
+		Read:   resourceAwsKinesisAnalyticsApplicationRead,
+		Update: resourceAwsKinesisAnalyticsApplicationUpdate,
+		Delete: resourceAwsKinesisAnalyticsApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		// An application's input can be changed but not removed
+		CustomizeDiff: customdiff.ForceNewIfChange("inputs", func(old, new, meta interface{}) bool {
+			return len(old.([]interface{})) > len(new.([]interface{}))
+		}),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cloudwatch_logging_options": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"log_stream_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateArn,
+						},
+					},
+				},
+			},
+
+			"code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 51200),
+			},
+
+			"create_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+
+			"inputs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kinesis_firehose": kinesisAnalyticsResourceSchema(),
+
+						"kinesis_stream": kinesisAnalyticsResourceSchema(),
+
+						"name_prefix": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+
+						"parallelism": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"count": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 64),
+									},
+								},
+							},
+						},
+
+						"processing_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"lambda": kinesisAnalyticsResourceSchemaRequired(),
+								},
+							},
+						},
+
+						"schema": kinesisAnalyticsSourceSchema(),
+
+						"starting_position_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"starting_position": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											kinesisanalytics.InputStartingPositionLastStoppedPoint,
+											kinesisanalytics.InputStartingPositionNow,
+											kinesisanalytics.InputStartingPositionTrimHorizon,
+										}, false),
+									},
+								},
+							},
+						},
+
+						"stream_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"last_update_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateKinesisAnalyticsApplicationName,
+			},
+
+			"outputs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"kinesis_firehose": kinesisAnalyticsResourceSchema(),
+
+						"kinesis_stream": kinesisAnalyticsResourceSchema(),
+
+						"lambda": kinesisAnalyticsResourceSchema(),
+
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+
+						"schema": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"record_format_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											kinesisanalytics.RecordFormatTypeCsv,
+											kinesisanalytics.RecordFormatTypeJson,
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"reference_data_sources": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"s3": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+
+									"file_key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"role_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateArn,
+									},
+								},
+							},
+						},
+
+						"schema": kinesisAnalyticsSourceSchema(),
+
+						"table_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 32),
+						},
+					},
+				},
+			},
+
+			"start_application": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// kinesisAnalyticsResourceSchema returns the schema of an optional input or
+// output, given as the ARN of the resource and of the role used to access it.
+func kinesisAnalyticsResourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateArn,
+				},
+			},
+		},
+	}
+}
+
+func kinesisAnalyticsResourceSchemaRequired() *schema.Schema {
+	s := kinesisAnalyticsResourceSchema()
+	s.Optional = false
+	s.Required = true
+	return s
+}
+
+// kinesisAnalyticsSourceSchema returns the schema of the records of an input
+// or of reference data.
+func kinesisAnalyticsSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"record_columns": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1000,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mapping": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+
+							"name": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+
+							"sql_type": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+
+				"record_encoding": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				"record_format": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mapping_parameters": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"csv": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"record_column_delimiter": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+
+													"record_row_delimiter": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+
+										"json": {
+											Type:     schema.TypeList,
+											Optional: true,
+											MaxItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"record_row_path": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+
+							"record_format_type": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAwsKinesisAnalyticsApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsconn
+	name := d.Get("name").(string)
+
+	input := &kinesisanalytics.CreateApplicationInput{
+		ApplicationName: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("code"); ok {
+		input.ApplicationCode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.ApplicationDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cloudwatch_logging_options"); ok {
+		input.CloudWatchLoggingOptions = []*kinesisanalytics.CloudWatchLoggingOption{
+			expandKinesisAnalyticsCloudWatchLoggingOption(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("inputs"); ok {
+		input.Inputs = []*kinesisanalytics.Input{
+			expandKinesisAnalyticsInput(v.([]interface{})[0].(map[string]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("outputs"); ok {
+		for _, o := range v.([]interface{}) {
+			input.Outputs = append(input.Outputs, expandKinesisAnalyticsOutput(o.(map[string]interface{})))
+		}
+	}
+
+	log.Printf("[DEBUG] Creating Kinesis Analytics Application: %s", input)
+	// IAM is eventually consistent, so the roles given may not be assumable
+	// yet right after their creation
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateApplication(input)
+		if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Kinesis Analytics service doesn't have sufficient privileges") {
+			return resource.RetryableError(err)
+		}
+		if isAWSErr(err, kinesisanalytics.ErrCodeInvalidArgumentException, "Please check the role provided or validity of the ARN") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating Kinesis Analytics Application (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	// Reference data sources cannot be given when creating the application
+	if v, ok := d.GetOk("reference_data_sources"); ok {
+		version, err := kinesisAnalyticsApplicationVersion(conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("error reading Kinesis Analytics Application (%s): %s", d.Id(), err)
+		}
+
+		_, err = conn.AddApplicationReferenceDataSource(&kinesisanalytics.AddApplicationReferenceDataSourceInput{
+			ApplicationName:             aws.String(d.Id()),
+			CurrentApplicationVersionId: aws.Int64(version),
+			ReferenceDataSource:         expandKinesisAnalyticsReferenceDataSource(v.([]interface{})[0].(map[string]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error adding Kinesis Analytics Application (%s) reference data source: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("start_application").(bool) {
+		if err := startKinesisAnalyticsApplication(conn, d, schema.TimeoutCreate); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsApplicationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsconn
+
+	output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+		ApplicationName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kinesisanalytics.ErrCodeResourceNotFoundException, "") {
+		log.Printf("[WARN] Kinesis Analytics Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	app := output.ApplicationDetail
+	if app == nil {
+		log.Printf("[WARN] Kinesis Analytics Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	status := aws.StringValue(app.ApplicationStatus)
+
+	d.Set("arn", app.ApplicationARN)
+	d.Set("code", app.ApplicationCode)
+	d.Set("create_timestamp", aws.TimeValue(app.CreateTimestamp).Format(time.RFC3339))
+	d.Set("description", app.ApplicationDescription)
+	d.Set("last_update_timestamp", aws.TimeValue(app.LastUpdateTimestamp).Format(time.RFC3339))
+	d.Set("name", app.ApplicationName)
+	d.Set("start_application", status == kinesisanalytics.ApplicationStatusRunning || status == kinesisanalytics.ApplicationStatusStarting)
+	d.Set("status", status)
+	d.Set("version", int(aws.Int64Value(app.ApplicationVersionId)))
+
+	if err := d.Set("cloudwatch_logging_options", flattenKinesisAnalyticsCloudWatchLoggingOptions(app.CloudWatchLoggingOptionDescriptions)); err != nil {
+		return fmt.Errorf("error setting cloudwatch_logging_options: %s", err)
+	}
+
+	if err := d.Set("inputs", flattenKinesisAnalyticsInputs(app.InputDescriptions)); err != nil {
+		return fmt.Errorf("error setting inputs: %s", err)
+	}
+
+	if err := d.Set("outputs", flattenKinesisAnalyticsOutputs(app.OutputDescriptions)); err != nil {
+		return fmt.Errorf("error setting outputs: %s", err)
+	}
+
+	if err := d.Set("reference_data_sources", flattenKinesisAnalyticsReferenceDataSources(app.ReferenceDataSourceDescriptions)); err != nil {
+		return fmt.Errorf("error setting reference_data_sources: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsKinesisAnalyticsApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsconn
+
+	// Every change to the application is made against its current version,
+	// which the change then bumps
+	version := int64(d.Get("version").(int))
+	apply := func(description string, f func(version int64) error) error {
+		log.Printf("[DEBUG] Kinesis Analytics Application (%s): %s", d.Id(), description)
+		if err := f(version); err != nil {
+			return fmt.Errorf("error updating Kinesis Analytics Application (%s), %s: %s", d.Id(), description, err)
+		}
+		version++
+
+		_, err := kinesisAnalyticsApplicationUpdatedWaiter.waitForResource(d, schema.TimeoutUpdate, kinesisAnalyticsApplicationStatus(conn, d.Id()))
+		if err != nil {
+			return fmt.Errorf("error waiting for Kinesis Analytics Application (%s) update: %s", d.Id(), err)
+		}
+		return nil
+	}
+
+	update := &kinesisanalytics.ApplicationUpdate{}
+	var changes []func(version int64) error
+
+	if d.HasChange("code") {
+		update.ApplicationCodeUpdate = aws.String(d.Get("code").(string))
+	}
+
+	if d.HasChange("cloudwatch_logging_options") {
+		o, n := d.GetChange("cloudwatch_logging_options")
+		oldOptions, newOptions := o.([]interface{}), n.([]interface{})
+
+		switch {
+		case len(oldOptions) == 0:
+			option := expandKinesisAnalyticsCloudWatchLoggingOption(newOptions[0].(map[string]interface{}))
+			changes = append(changes, func(version int64) error {
+				_, err := conn.AddApplicationCloudWatchLoggingOption(&kinesisanalytics.AddApplicationCloudWatchLoggingOptionInput{
+					ApplicationName:             aws.String(d.Id()),
+					CloudWatchLoggingOption:     option,
+					CurrentApplicationVersionId: aws.Int64(version),
+				})
+				return err
+			})
+		case len(newOptions) == 0:
+			id := oldOptions[0].(map[string]interface{})["id"].(string)
+			changes = append(changes, func(version int64) error {
+				_, err := conn.DeleteApplicationCloudWatchLoggingOption(&kinesisanalytics.DeleteApplicationCloudWatchLoggingOptionInput{
+					ApplicationName:             aws.String(d.Id()),
+					CloudWatchLoggingOptionId:   aws.String(id),
+					CurrentApplicationVersionId: aws.Int64(version),
+				})
+				return err
+			})
+		default:
+			m := newOptions[0].(map[string]interface{})
+			update.CloudWatchLoggingOptionUpdates = []*kinesisanalytics.CloudWatchLoggingOptionUpdate{
+				{
+					CloudWatchLoggingOptionId: aws.String(oldOptions[0].(map[string]interface{})["id"].(string)),
+					LogStreamARNUpdate:        aws.String(m["log_stream_arn"].(string)),
+					RoleARNUpdate:             aws.String(m["role_arn"].(string)),
+				},
+			}
+		}
+	}
+
+	if d.HasChange("inputs") {
+		o, n := d.GetChange("inputs")
+		oldInputs, newInputs := o.([]interface{}), n.([]interface{})
+
+		// Removing the input recreates the application
+		if len(oldInputs) == 0 {
+			input := expandKinesisAnalyticsInput(newInputs[0].(map[string]interface{}))
+			changes = append(changes, func(version int64) error {
+				_, err := conn.AddApplicationInput(&kinesisanalytics.AddApplicationInputInput{
+					ApplicationName:             aws.String(d.Id()),
+					CurrentApplicationVersionId: aws.Int64(version),
+					Input:                       input,
+				})
+				return err
+			})
+		} else {
+			oldInput := oldInputs[0].(map[string]interface{})
+			newInput := newInputs[0].(map[string]interface{})
+			id := oldInput["id"].(string)
+
+			inputUpdate := expandKinesisAnalyticsInputUpdate(id, newInput)
+			update.InputUpdates = []*kinesisanalytics.InputUpdate{inputUpdate}
+
+			// Processing configurations are added and removed separately
+			oldLambda := oldInput["processing_configuration"].([]interface{})
+			newLambda := newInput["processing_configuration"].([]interface{})
+			switch {
+			case len(oldLambda) == 0 && len(newLambda) > 0:
+				inputUpdate.InputProcessingConfigurationUpdate = nil
+
+				configuration := expandKinesisAnalyticsInputProcessingConfiguration(newLambda)
+				changes = append(changes, func(version int64) error {
+					_, err := conn.AddApplicationInputProcessingConfiguration(&kinesisanalytics.AddApplicationInputProcessingConfigurationInput{
+						ApplicationName:              aws.String(d.Id()),
+						CurrentApplicationVersionId:  aws.Int64(version),
+						InputId:                      aws.String(id),
+						InputProcessingConfiguration: configuration,
+					})
+					return err
+				})
+			case len(oldLambda) > 0 && len(newLambda) == 0:
+				changes = append(changes, func(version int64) error {
+					_, err := conn.DeleteApplicationInputProcessingConfiguration(&kinesisanalytics.DeleteApplicationInputProcessingConfigurationInput{
+						ApplicationName:             aws.String(d.Id()),
+						CurrentApplicationVersionId: aws.Int64(version),
+						InputId:                     aws.String(id),
+					})
+					return err
+				})
+			}
+		}
+	}
+
+	if d.HasChange("outputs") {
+		o, n := d.GetChange("outputs")
+
+		oldOutputs := make(map[string]map[string]interface{})
+		for _, v := range o.([]interface{}) {
+			m := v.(map[string]interface{})
+			oldOutputs[m["name"].(string)] = m
+		}
+
+		newNames := make(map[string]bool)
+		for _, v := range n.([]interface{}) {
+			m := v.(map[string]interface{})
+			name := m["name"].(string)
+			newNames[name] = true
+
+			oldOutput, ok := oldOutputs[name]
+			if !ok {
+				output := expandKinesisAnalyticsOutput(m)
+				changes = append(changes, func(version int64) error {
+					_, err := conn.AddApplicationOutput(&kinesisanalytics.AddApplicationOutputInput{
+						ApplicationName:             aws.String(d.Id()),
+						CurrentApplicationVersionId: aws.Int64(version),
+						Output:                      output,
+					})
+					return err
+				})
+				continue
+			}
+
+			if !kinesisAnalyticsOutputsEqual(oldOutput, m) {
+				update.OutputUpdates = append(update.OutputUpdates, expandKinesisAnalyticsOutputUpdate(oldOutput["id"].(string), m))
+			}
+		}
+
+		for name, oldOutput := range oldOutputs {
+			if newNames[name] {
+				continue
+			}
+			id := oldOutput["id"].(string)
+			changes = append(changes, func(version int64) error {
+				_, err := conn.DeleteApplicationOutput(&kinesisanalytics.DeleteApplicationOutputInput{
+					ApplicationName:             aws.String(d.Id()),
+					CurrentApplicationVersionId: aws.Int64(version),
+					OutputId:                    aws.String(id),
+				})
+				return err
+			})
+		}
+	}
+
+	if d.HasChange("reference_data_sources") {
+		o, n := d.GetChange("reference_data_sources")
+		oldSources, newSources := o.([]interface{}), n.([]interface{})
+
+		switch {
+		case len(oldSources) == 0:
+			source := expandKinesisAnalyticsReferenceDataSource(newSources[0].(map[string]interface{}))
+			changes = append(changes, func(version int64) error {
+				_, err := conn.AddApplicationReferenceDataSource(&kinesisanalytics.AddApplicationReferenceDataSourceInput{
+					ApplicationName:             aws.String(d.Id()),
+					CurrentApplicationVersionId: aws.Int64(version),
+					ReferenceDataSource:         source,
+				})
+				return err
+			})
+		case len(newSources) == 0:
+			id := oldSources[0].(map[string]interface{})["id"].(string)
+			changes = append(changes, func(version int64) error {
+				_, err := conn.DeleteApplicationReferenceDataSource(&kinesisanalytics.DeleteApplicationReferenceDataSourceInput{
+					ApplicationName:             aws.String(d.Id()),
+					CurrentApplicationVersionId: aws.Int64(version),
+					ReferenceId:                 aws.String(id),
+				})
+				return err
+			})
+		default:
+			id := oldSources[0].(map[string]interface{})["id"].(string)
+			update.ReferenceDataSourceUpdates = []*kinesisanalytics.ReferenceDataSourceUpdate{
+				expandKinesisAnalyticsReferenceDataSourceUpdate(id, newSources[0].(map[string]interface{})),
+			}
+		}
+	}
+
+	if !reflect.DeepEqual(update, &kinesisanalytics.ApplicationUpdate{}) {
+		err := apply("updating application", func(version int64) error {
+			_, err := conn.UpdateApplication(&kinesisanalytics.UpdateApplicationInput{
+				ApplicationName:             aws.String(d.Id()),
+				ApplicationUpdate:           update,
+				CurrentApplicationVersionId: aws.Int64(version),
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, change := range changes {
+		if err := apply("applying change", change); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("start_application") {
+		if d.Get("start_application").(bool) {
+			if err := startKinesisAnalyticsApplication(conn, d, schema.TimeoutUpdate); err != nil {
+				return err
+			}
+		} else {
+			if err := stopKinesisAnalyticsApplication(conn, d, schema.TimeoutUpdate); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceAwsKinesisAnalyticsApplicationRead(d, meta)
+}
+
+func resourceAwsKinesisAnalyticsApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).kinesisanalyticsconn
+
+	// The application is deleted by giving its exact creation time
+	output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+		ApplicationName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, kinesisanalytics.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting Kinesis Analytics Application: %s", d.Id())
+	_, err = conn.DeleteApplication(&kinesisanalytics.DeleteApplicationInput{
+		ApplicationName: aws.String(d.Id()),
+		CreateTimestamp: output.ApplicationDetail.CreateTimestamp,
+	})
+
+	if isAWSErr(err, kinesisanalytics.ErrCodeResourceNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	_, err = kinesisAnalyticsApplicationDeletedWaiter.waitForResource(d, schema.TimeoutDelete, kinesisAnalyticsApplicationStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics Application (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func kinesisAnalyticsApplicationVersion(conn *kinesisanalytics.KinesisAnalytics, name string) (int64, error) {
+	output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+		ApplicationName: aws.String(name),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return aws.Int64Value(output.ApplicationDetail.ApplicationVersionId), nil
+}
+
+// startKinesisAnalyticsApplication starts reading the application's input
+// from its configured starting position, by default from now on.
+func startKinesisAnalyticsApplication(conn *kinesisanalytics.KinesisAnalytics, d *schema.ResourceData, timeoutKey string) error {
+	output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+		ApplicationName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error reading Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	if len(output.ApplicationDetail.InputDescriptions) == 0 {
+		return fmt.Errorf("error starting Kinesis Analytics Application (%s): the application has no input", d.Id())
+	}
+
+	position := kinesisanalytics.InputStartingPositionNow
+	if v, ok := d.GetOk("inputs.0.starting_position_configuration.0.starting_position"); ok {
+		position = v.(string)
+	}
+
+	input := &kinesisanalytics.StartApplicationInput{
+		ApplicationName: aws.String(d.Id()),
+		InputConfigurations: []*kinesisanalytics.InputConfiguration{
+			{
+				Id: output.ApplicationDetail.InputDescriptions[0].InputId,
+				InputStartingPositionConfiguration: &kinesisanalytics.InputStartingPositionConfiguration{
+					InputStartingPosition: aws.String(position),
+				},
+			},
+		},
+	}
+
+	log.Printf("[DEBUG] Starting Kinesis Analytics Application: %s", input)
+	if _, err := conn.StartApplication(input); err != nil {
+		return fmt.Errorf("error starting Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	_, err = kinesisAnalyticsApplicationRunningWaiter.waitForResource(d, timeoutKey, kinesisAnalyticsApplicationStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics Application (%s) to start: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func stopKinesisAnalyticsApplication(conn *kinesisanalytics.KinesisAnalytics, d *schema.ResourceData, timeoutKey string) error {
+	log.Printf("[DEBUG] Stopping Kinesis Analytics Application: %s", d.Id())
+	_, err := conn.StopApplication(&kinesisanalytics.StopApplicationInput{
+		ApplicationName: aws.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("error stopping Kinesis Analytics Application (%s): %s", d.Id(), err)
+	}
+
+	_, err = kinesisAnalyticsApplicationReadyWaiter.waitForResource(d, timeoutKey, kinesisAnalyticsApplicationStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for Kinesis Analytics Application (%s) to stop: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandKinesisAnalyticsCloudWatchLoggingOption(m map[string]interface{}) *kinesisanalytics.CloudWatchLoggingOption {
+	return &kinesisanalytics.CloudWatchLoggingOption{
+		LogStreamARN: aws.String(m["log_stream_arn"].(string)),
+		RoleARN:      aws.String(m["role_arn"].(string)),
+	}
+}
+
+func expandKinesisAnalyticsInput(m map[string]interface{}) *kinesisanalytics.Input {
+	input := &kinesisanalytics.Input{
+		NamePrefix:  aws.String(m["name_prefix"].(string)),
+		InputSchema: expandKinesisAnalyticsSourceSchema(m["schema"].([]interface{})),
+	}
+
+	if arn, role, ok := expandKinesisAnalyticsResource(m["kinesis_firehose"].([]interface{})); ok {
+		input.KinesisFirehoseInput = &kinesisanalytics.KinesisFirehoseInput{
+			ResourceARN: arn,
+			RoleARN:     role,
+		}
+	}
+
+	if arn, role, ok := expandKinesisAnalyticsResource(m["kinesis_stream"].([]interface{})); ok {
+		input.KinesisStreamsInput = &kinesisanalytics.KinesisStreamsInput{
+			ResourceARN: arn,
+			RoleARN:     role,
+		}
+	}
+
+	if v := m["parallelism"].([]interface{}); len(v) > 0 && v[0] != nil {
+		input.InputParallelism = &kinesisanalytics.InputParallelism{
+			Count: aws.Int64(int64(v[0].(map[string]interface{})["count"].(int))),
+		}
+	}
+
+	input.InputProcessingConfiguration = expandKinesisAnalyticsInputProcessingConfiguration(m["processing_configuration"].([]interface{}))
+
+	return input
+}
+
+func expandKinesisAnalyticsInputUpdate(id string, m map[string]interface{}) *kinesisanalytics.InputUpdate {
+	input := expandKinesisAnalyticsInput(m)
+
+	inputUpdate := &kinesisanalytics.InputUpdate{
+		InputId:          aws.String(id),
+		NamePrefixUpdate: input.NamePrefix,
+		InputSchemaUpdate: &kinesisanalytics.InputSchemaUpdate{
+			RecordColumnUpdates:  input.InputSchema.RecordColumns,
+			RecordEncodingUpdate: input.InputSchema.RecordEncoding,
+			RecordFormatUpdate:   input.InputSchema.RecordFormat,
+		},
+	}
+
+	if v := input.KinesisFirehoseInput; v != nil {
+		inputUpdate.KinesisFirehoseInputUpdate = &kinesisanalytics.KinesisFirehoseInputUpdate{
+			ResourceARNUpdate: v.ResourceARN,
+			RoleARNUpdate:     v.RoleARN,
+		}
+	}
+
+	if v := input.KinesisStreamsInput; v != nil {
+		inputUpdate.KinesisStreamsInputUpdate = &kinesisanalytics.KinesisStreamsInputUpdate{
+			ResourceARNUpdate: v.ResourceARN,
+			RoleARNUpdate:     v.RoleARN,
+		}
+	}
+
+	if v := input.InputParallelism; v != nil {
+		inputUpdate.InputParallelismUpdate = &kinesisanalytics.InputParallelismUpdate{
+			CountUpdate: v.Count,
+		}
+	}
+
+	if v := input.InputProcessingConfiguration; v != nil {
+		inputUpdate.InputProcessingConfigurationUpdate = &kinesisanalytics.InputProcessingConfigurationUpdate{
+			InputLambdaProcessorUpdate: &kinesisanalytics.InputLambdaProcessorUpdate{
+				ResourceARNUpdate: v.InputLambdaProcessor.ResourceARN,
+				RoleARNUpdate:     v.InputLambdaProcessor.RoleARN,
+			},
+		}
+	}
+
+	return inputUpdate
+}
+
+func expandKinesisAnalyticsInputProcessingConfiguration(l []interface{}) *kinesisanalytics.InputProcessingConfiguration {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	arn, role, ok := expandKinesisAnalyticsResource(l[0].(map[string]interface{})["lambda"].([]interface{}))
+	if !ok {
+		return nil
+	}
+
+	return &kinesisanalytics.InputProcessingConfiguration{
+		InputLambdaProcessor: &kinesisanalytics.InputLambdaProcessor{
+			ResourceARN: arn,
+			RoleARN:     role,
+		},
+	}
+}
+
+func expandKinesisAnalyticsOutput(m map[string]interface{}) *kinesisanalytics.Output {
+	output := &kinesisanalytics.Output{
+		Name: aws.String(m["name"].(string)),
+	}
+
+	if v := m["schema"].([]interface{}); len(v) > 0 && v[0] != nil {
+		output.DestinationSchema = &kinesisanalytics.DestinationSchema{
+			RecordFormatType: aws.String(v[0].(map[string]interface{})["record_format_type"].(string)),
+		}
+	}
+
+	if arn, role, ok := expandKinesisAnalyticsResource(m["kinesis_firehose"].([]interface{})); ok {
+		output.KinesisFirehoseOutput = &kinesisanalytics.KinesisFirehoseOutput{
+			ResourceARN: arn,
+			RoleARN:     role,
+		}
+	}
+
+	if arn, role, ok := expandKinesisAnalyticsResource(m["kinesis_stream"].([]interface{})); ok {
+		output.KinesisStreamsOutput = &kinesisanalytics.KinesisStreamsOutput{
+			ResourceARN: arn,
+			RoleARN:     role,
+		}
+	}
+
+	if arn, role, ok := expandKinesisAnalyticsResource(m["lambda"].([]interface{})); ok {
+		output.LambdaOutput = &kinesisanalytics.LambdaOutput{
+			ResourceARN: arn,
+			RoleARN:     role,
+		}
+	}
+
+	return output
+}
+
+func expandKinesisAnalyticsOutputUpdate(id string, m map[string]interface{}) *kinesisanalytics.OutputUpdate {
+	output := expandKinesisAnalyticsOutput(m)
+
+	outputUpdate := &kinesisanalytics.OutputUpdate{
+		DestinationSchemaUpdate: output.DestinationSchema,
+		NameUpdate:              output.Name,
+		OutputId:                aws.String(id),
+	}
+
+	if v := output.KinesisFirehoseOutput; v != nil {
+		outputUpdate.KinesisFirehoseOutputUpdate = &kinesisanalytics.KinesisFirehoseOutputUpdate{
+			ResourceARNUpdate: v.ResourceARN,
+			RoleARNUpdate:     v.RoleARN,
+		}
+	}
+
+	if v := output.KinesisStreamsOutput; v != nil {
+		outputUpdate.KinesisStreamsOutputUpdate = &kinesisanalytics.KinesisStreamsOutputUpdate{
+			ResourceARNUpdate: v.ResourceARN,
+			RoleARNUpdate:     v.RoleARN,
+		}
+	}
+
+	if v := output.LambdaOutput; v != nil {
+		outputUpdate.LambdaOutputUpdate = &kinesisanalytics.LambdaOutputUpdate{
+			ResourceARNUpdate: v.ResourceARN,
+			RoleARNUpdate:     v.RoleARN,
+		}
+	}
+
+	return outputUpdate
+}
+
+// kinesisAnalyticsOutputsEqual compares two configured outputs, ignoring
+// their computed IDs.
+func kinesisAnalyticsOutputsEqual(a, b map[string]interface{}) bool {
+	return reflect.DeepEqual(expandKinesisAnalyticsOutput(a), expandKinesisAnalyticsOutput(b))
+}
+
+func expandKinesisAnalyticsReferenceDataSource(m map[string]interface{}) *kinesisanalytics.ReferenceDataSource {
+	source := &kinesisanalytics.ReferenceDataSource{
+		ReferenceSchema: expandKinesisAnalyticsSourceSchema(m["schema"].([]interface{})),
+		TableName:       aws.String(m["table_name"].(string)),
+	}
+
+	if v := m["s3"].([]interface{}); len(v) > 0 && v[0] != nil {
+		s3 := v[0].(map[string]interface{})
+		source.S3ReferenceDataSource = &kinesisanalytics.S3ReferenceDataSource{
+			BucketARN:        aws.String(s3["bucket_arn"].(string)),
+			FileKey:          aws.String(s3["file_key"].(string)),
+			ReferenceRoleARN: aws.String(s3["role_arn"].(string)),
+		}
+	}
+
+	return source
+}
+
+func expandKinesisAnalyticsReferenceDataSourceUpdate(id string, m map[string]interface{}) *kinesisanalytics.ReferenceDataSourceUpdate {
+	source := expandKinesisAnalyticsReferenceDataSource(m)
+
+	sourceUpdate := &kinesisanalytics.ReferenceDataSourceUpdate{
+		ReferenceId:           aws.String(id),
+		ReferenceSchemaUpdate: source.ReferenceSchema,
+		TableNameUpdate:       source.TableName,
+	}
+
+	if v := source.S3ReferenceDataSource; v != nil {
+		sourceUpdate.S3ReferenceDataSourceUpdate = &kinesisanalytics.S3ReferenceDataSourceUpdate{
+			BucketARNUpdate:        v.BucketARN,
+			FileKeyUpdate:          v.FileKey,
+			ReferenceRoleARNUpdate: v.ReferenceRoleARN,
+		}
+	}
+
+	return sourceUpdate
+}
+
+// expandKinesisAnalyticsResource returns the resource and role ARNs of an
+// input or output, and whether it is configured.
+func expandKinesisAnalyticsResource(l []interface{}) (*string, *string, bool) {
+	if len(l) == 0 || l[0] == nil {
+		return nil, nil, false
+	}
+
+	m := l[0].(map[string]interface{})
+	return aws.String(m["resource_arn"].(string)), aws.String(m["role_arn"].(string)), true
+}
+
+func expandKinesisAnalyticsSourceSchema(l []interface{}) *kinesisanalytics.SourceSchema {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+
+	sourceSchema := &kinesisanalytics.SourceSchema{
+		RecordFormat: expandKinesisAnalyticsRecordFormat(m["record_format"].([]interface{})),
+	}
+
+	for _, v := range m["record_columns"].([]interface{}) {
+		column := v.(map[string]interface{})
+		recordColumn := &kinesisanalytics.RecordColumn{
+			Name:    aws.String(column["name"].(string)),
+			SqlType: aws.String(column["sql_type"].(string)),
+		}
+		if mapping, ok := column["mapping"].(string); ok && mapping != "" {
+			recordColumn.Mapping = aws.String(mapping)
+		}
+		sourceSchema.RecordColumns = append(sourceSchema.RecordColumns, recordColumn)
+	}
+
+	if v, ok := m["record_encoding"].(string); ok && v != "" {
+		sourceSchema.RecordEncoding = aws.String(v)
+	}
+
+	return sourceSchema
+}
+
+// expandKinesisAnalyticsRecordFormat derives the record format type from the
+// mapping parameters given, as records are JSON when none are.
+func expandKinesisAnalyticsRecordFormat(l []interface{}) *kinesisanalytics.RecordFormat {
+	recordFormat := &kinesisanalytics.RecordFormat{
+		RecordFormatType: aws.String(kinesisanalytics.RecordFormatTypeJson),
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return recordFormat
+	}
+
+	v := l[0].(map[string]interface{})["mapping_parameters"].([]interface{})
+	if len(v) == 0 || v[0] == nil {
+		return recordFormat
+	}
+	m := v[0].(map[string]interface{})
+
+	if v := m["csv"].([]interface{}); len(v) > 0 && v[0] != nil {
+		csv := v[0].(map[string]interface{})
+		recordFormat.RecordFormatType = aws.String(kinesisanalytics.RecordFormatTypeCsv)
+		recordFormat.MappingParameters = &kinesisanalytics.MappingParameters{
+			CSVMappingParameters: &kinesisanalytics.CSVMappingParameters{
+				RecordColumnDelimiter: aws.String(csv["record_column_delimiter"].(string)),
+				RecordRowDelimiter:    aws.String(csv["record_row_delimiter"].(string)),
+			},
+		}
+	}
+
+	if v := m["json"].([]interface{}); len(v) > 0 && v[0] != nil {
+		json := v[0].(map[string]interface{})
+		recordFormat.RecordFormatType = aws.String(kinesisanalytics.RecordFormatTypeJson)
+		recordFormat.MappingParameters = &kinesisanalytics.MappingParameters{
+			JSONMappingParameters: &kinesisanalytics.JSONMappingParameters{
+				RecordRowPath: aws.String(json["record_row_path"].(string)),
+			},
+		}
+	}
+
+	return recordFormat
+}
+
+func flattenKinesisAnalyticsCloudWatchLoggingOptions(options []*kinesisanalytics.CloudWatchLoggingOptionDescription) []interface{} {
+	result := make([]interface{}, 0, len(options))
+	for _, option := range options {
+		result = append(result, map[string]interface{}{
+			"id":             aws.StringValue(option.CloudWatchLoggingOptionId),
+			"log_stream_arn": aws.StringValue(option.LogStreamARN),
+			"role_arn":       aws.StringValue(option.RoleARN),
+		})
+	}
+
+	return result
+}
+
+func flattenKinesisAnalyticsInputs(inputs []*kinesisanalytics.InputDescription) []interface{} {
+	result := make([]interface{}, 0, len(inputs))
+	for _, input := range inputs {
+		m := map[string]interface{}{
+			"id":           aws.StringValue(input.InputId),
+			"name_prefix":  aws.StringValue(input.NamePrefix),
+			"schema":       flattenKinesisAnalyticsSourceSchema(input.InputSchema),
+			"stream_names": flattenStringList(input.InAppStreamNames),
+		}
+
+		if v := input.KinesisFirehoseInputDescription; v != nil {
+			m["kinesis_firehose"] = flattenKinesisAnalyticsResource(v.ResourceARN, v.RoleARN)
+		}
+
+		if v := input.KinesisStreamsInputDescription; v != nil {
+			m["kinesis_stream"] = flattenKinesisAnalyticsResource(v.ResourceARN, v.RoleARN)
+		}
+
+		if v := input.InputParallelism; v != nil {
+			m["parallelism"] = []interface{}{
+				map[string]interface{}{
+					"count": int(aws.Int64Value(v.Count)),
+				},
+			}
+		}
+
+		if v := input.InputProcessingConfigurationDescription; v != nil && v.InputLambdaProcessorDescription != nil {
+			m["processing_configuration"] = []interface{}{
+				map[string]interface{}{
+					"lambda": flattenKinesisAnalyticsResource(v.InputLambdaProcessorDescription.ResourceARN, v.InputLambdaProcessorDescription.RoleARN),
+				},
+			}
+		}
+
+		if v := input.InputStartingPositionConfiguration; v != nil {
+			m["starting_position_configuration"] = []interface{}{
+				map[string]interface{}{
+					"starting_position": aws.StringValue(v.InputStartingPosition),
+				},
+			}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func flattenKinesisAnalyticsOutputs(outputs []*kinesisanalytics.OutputDescription) []interface{} {
+	result := make([]interface{}, 0, len(outputs))
+	for _, output := range outputs {
+		m := map[string]interface{}{
+			"id":   aws.StringValue(output.OutputId),
+			"name": aws.StringValue(output.Name),
+		}
+
+		if v := output.DestinationSchema; v != nil {
+			m["schema"] = []interface{}{
+				map[string]interface{}{
+					"record_format_type": aws.StringValue(v.RecordFormatType),
+				},
+			}
+		}
+
+		if v := output.KinesisFirehoseOutputDescription; v != nil {
+			m["kinesis_firehose"] = flattenKinesisAnalyticsResource(v.ResourceARN, v.RoleARN)
+		}
+
+		if v := output.KinesisStreamsOutputDescription; v != nil {
+			m["kinesis_stream"] = flattenKinesisAnalyticsResource(v.ResourceARN, v.RoleARN)
+		}
+
+		if v := output.LambdaOutputDescription; v != nil {
+			m["lambda"] = flattenKinesisAnalyticsResource(v.ResourceARN, v.RoleARN)
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func flattenKinesisAnalyticsReferenceDataSources(sources []*kinesisanalytics.ReferenceDataSourceDescription) []interface{} {
+	result := make([]interface{}, 0, len(sources))
+	for _, source := range sources {
+		m := map[string]interface{}{
+			"id":         aws.StringValue(source.ReferenceId),
+			"schema":     flattenKinesisAnalyticsSourceSchema(source.ReferenceSchema),
+			"table_name": aws.StringValue(source.TableName),
+		}
+
+		if v := source.S3ReferenceDataSourceDescription; v != nil {
+			m["s3"] = []interface{}{
+				map[string]interface{}{
+					"bucket_arn": aws.StringValue(v.BucketARN),
+					"file_key":   aws.StringValue(v.FileKey),
+					"role_arn":   aws.StringValue(v.ReferenceRoleARN),
+				},
+			}
+		}
+
+		result = append(result, m)
+	}
+
+	return result
+}
+
+func flattenKinesisAnalyticsResource(arn, role *string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"resource_arn": aws.StringValue(arn),
+			"role_arn":     aws.StringValue(role),
+		},
+	}
+}
+
+func flattenKinesisAnalyticsSourceSchema(sourceSchema *kinesisanalytics.SourceSchema) []interface{} {
+	if sourceSchema == nil {
+		return []interface{}{}
+	}
+
+	columns := make([]interface{}, 0, len(sourceSchema.RecordColumns))
+	for _, column := range sourceSchema.RecordColumns {
+		columns = append(columns, map[string]interface{}{
+			"mapping":  aws.StringValue(column.Mapping),
+			"name":     aws.StringValue(column.Name),
+			"sql_type": aws.StringValue(column.SqlType),
+		})
+	}
+
+	recordFormat := map[string]interface{}{}
+	if v := sourceSchema.RecordFormat; v != nil {
+		recordFormat["record_format_type"] = aws.StringValue(v.RecordFormatType)
+
+		if p := v.MappingParameters; p != nil {
+			mappingParameters := map[string]interface{}{}
+			if csv := p.CSVMappingParameters; csv != nil {
+				mappingParameters["csv"] = []interface{}{
+					map[string]interface{}{
+						"record_column_delimiter": aws.StringValue(csv.RecordColumnDelimiter),
+						"record_row_delimiter":    aws.StringValue(csv.RecordRowDelimiter),
+					},
+				}
+			}
+			if json := p.JSONMappingParameters; json != nil {
+				mappingParameters["json"] = []interface{}{
+					map[string]interface{}{
+						"record_row_path": aws.StringValue(json.RecordRowPath),
+					},
+				}
+			}
+			recordFormat["mapping_parameters"] = []interface{}{mappingParameters}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"record_columns":  columns,
+			"record_encoding": aws.StringValue(sourceSchema.RecordEncoding),
+			"record_format":   []interface{}{recordFormat},
+		},
+	}
+}
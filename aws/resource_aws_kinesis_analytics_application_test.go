@@ -0,0 +1,546 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSKinesisAnalyticsApplication_basic(t *testing.T) {
+	var application kinesisanalytics.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_analytics_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisAnalyticsApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfig(rName, "testCode\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "code", "testCode\n"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status", kinesisanalytics.ApplicationStatusReady),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "create_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfig(rName, "testCode2\n"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "code", "testCode2\n"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsApplication_cloudwatchLoggingOptions(t *testing.T) {
+	var application kinesisanalytics.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_analytics_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisAnalyticsApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigCloudwatchLoggingOptions(rName, "test1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", "aws_cloudwatch_log_stream.test1", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.role_arn", "aws_iam_role.test", "arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigCloudwatchLoggingOptions(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "cloudwatch_logging_options.0.log_stream_arn", "aws_cloudwatch_log_stream.test2", "arn"),
+				),
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfig(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "cloudwatch_logging_options.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsApplication_inputs(t *testing.T) {
+	var application kinesisanalytics.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_analytics_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisAnalyticsApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigInputs(rName, "test_prefix", "COLUMN_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.name_prefix", "test_prefix"),
+					resource.TestCheckResourceAttrPair(resourceName, "inputs.0.kinesis_stream.0.resource_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.parallelism.0.count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.schema.0.record_columns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.schema.0.record_columns.0.name", "COLUMN_1"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.schema.0.record_format.0.record_format_type", kinesisanalytics.RecordFormatTypeJson),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.schema.0.record_format.0.mapping_parameters.0.json.0.record_row_path", "$"),
+					resource.TestCheckResourceAttrSet(resourceName, "inputs.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigInputs(rName, "test_prefix2", "COLUMN_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "inputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.name_prefix", "test_prefix2"),
+					resource.TestCheckResourceAttr(resourceName, "inputs.0.schema.0.record_columns.0.name", "COLUMN_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsApplication_outputs(t *testing.T) {
+	var application kinesisanalytics.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_analytics_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisAnalyticsApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigOutputs(rName, "test_name", kinesisanalytics.RecordFormatTypeJson),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.0.name", "test_name"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.0.schema.0.record_format_type", kinesisanalytics.RecordFormatTypeJson),
+					resource.TestCheckResourceAttrPair(resourceName, "outputs.0.kinesis_stream.0.resource_arn", "aws_kinesis_stream.test", "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "outputs.0.id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigOutputs(rName, "test_name", kinesisanalytics.RecordFormatTypeCsv),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.0.schema.0.record_format_type", kinesisanalytics.RecordFormatTypeCsv),
+				),
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigOutputs(rName, "test_name2", kinesisanalytics.RecordFormatTypeCsv),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "outputs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "outputs.0.name", "test_name2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsApplication_referenceDataSources(t *testing.T) {
+	var application kinesisanalytics.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_analytics_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisAnalyticsApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigReferenceDataSources(rName, "test_table"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "reference_data_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reference_data_sources.0.table_name", "test_table"),
+					resource.TestCheckResourceAttrPair(resourceName, "reference_data_sources.0.s3.0.bucket_arn", "aws_s3_bucket.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "reference_data_sources.0.s3.0.file_key", "test_file_key"),
+					resource.TestCheckResourceAttr(resourceName, "reference_data_sources.0.schema.0.record_format.0.record_format_type", kinesisanalytics.RecordFormatTypeCsv),
+					resource.TestCheckResourceAttrSet(resourceName, "reference_data_sources.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigReferenceDataSources(rName, "test_table2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "reference_data_sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reference_data_sources.0.table_name", "test_table2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSKinesisAnalyticsApplication_startApplication(t *testing.T) {
+	var application kinesisanalytics.ApplicationDetail
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_kinesis_analytics_application.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSKinesisAnalyticsApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigStartApplication(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "true"),
+					resource.TestCheckResourceAttr(resourceName, "status", kinesisanalytics.ApplicationStatusRunning),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSKinesisAnalyticsApplicationConfigStartApplication(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSKinesisAnalyticsApplicationExists(resourceName, &application),
+					resource.TestCheckResourceAttr(resourceName, "start_application", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", kinesisanalytics.ApplicationStatusReady),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSKinesisAnalyticsApplicationDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_kinesis_analytics_application" {
+			continue
+		}
+
+		output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+			ApplicationName: aws.String(rs.Primary.ID),
+		})
+
+		if isAWSErr(err, kinesisanalytics.ErrCodeResourceNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading Kinesis Analytics Application (%s): %s", rs.Primary.ID, err)
+		}
+
+		if output != nil && output.ApplicationDetail != nil {
+			return fmt.Errorf("Kinesis Analytics Application (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSKinesisAnalyticsApplicationExists(n string, application *kinesisanalytics.ApplicationDetail) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Kinesis Analytics Application ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).kinesisanalyticsconn
+		output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+			ApplicationName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*application = *output.ApplicationDetail
+
+		return nil
+	}
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigRole(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "assume_role" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["kinesisanalytics.amazonaws.com"]
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = "${data.aws_iam_policy_document.assume_role.json}"
+}
+
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions = [
+      "firehose:*",
+      "kinesis:*",
+      "lambda:*",
+      "logs:*",
+      "s3:*",
+    ]
+
+    resources = ["*"]
+  }
+}
+
+resource "aws_iam_role_policy" "test" {
+  name   = %[1]q
+  role   = "${aws_iam_role.test.id}"
+  policy = "${data.aws_iam_policy_document.test.json}"
+}
+`, rName)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigKinesisStream(rName string) string {
+	return testAccAWSKinesisAnalyticsApplicationConfigRole(rName) + fmt.Sprintf(`
+resource "aws_kinesis_stream" "test" {
+  name        = %[1]q
+  shard_count = 1
+}
+`, rName)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfig(rName, code string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_analytics_application" "test" {
+  name = %[1]q
+  code = %[2]q
+}
+`, rName, code)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigCloudwatchLoggingOptions(rName, streamName string) string {
+	return testAccAWSKinesisAnalyticsApplicationConfigRole(rName) + fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_cloudwatch_log_stream" "test1" {
+  name           = "test1"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_cloudwatch_log_stream" "test2" {
+  name           = "test2"
+  log_group_name = "${aws_cloudwatch_log_group.test.name}"
+}
+
+resource "aws_kinesis_analytics_application" "test" {
+  name = %[1]q
+
+  cloudwatch_logging_options {
+    log_stream_arn = "${aws_cloudwatch_log_stream.%[2]s.arn}"
+    role_arn       = "${aws_iam_role.test.arn}"
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, streamName)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigInputs(rName, namePrefix, columnName string) string {
+	return testAccAWSKinesisAnalyticsApplicationConfigKinesisStream(rName) + fmt.Sprintf(`
+resource "aws_kinesis_analytics_application" "test" {
+  name = %[1]q
+
+  inputs {
+    name_prefix = %[2]q
+
+    kinesis_stream {
+      resource_arn = "${aws_kinesis_stream.test.arn}"
+      role_arn     = "${aws_iam_role.test.arn}"
+    }
+
+    parallelism {
+      count = 1
+    }
+
+    schema {
+      record_columns {
+        mapping  = "$.test"
+        name     = %[3]q
+        sql_type = "VARCHAR(8)"
+      }
+
+      record_encoding = "UTF-8"
+
+      record_format {
+        mapping_parameters {
+          json {
+            record_row_path = "$"
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, namePrefix, columnName)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigOutputs(rName, name, recordFormatType string) string {
+	return testAccAWSKinesisAnalyticsApplicationConfigKinesisStream(rName) + fmt.Sprintf(`
+resource "aws_kinesis_analytics_application" "test" {
+  name = %[1]q
+
+  outputs {
+    name = %[2]q
+
+    kinesis_stream {
+      resource_arn = "${aws_kinesis_stream.test.arn}"
+      role_arn     = "${aws_iam_role.test.arn}"
+    }
+
+    schema {
+      record_format_type = %[3]q
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, name, recordFormatType)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigReferenceDataSources(rName, tableName string) string {
+	return testAccAWSKinesisAnalyticsApplicationConfigRole(rName) + fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_kinesis_analytics_application" "test" {
+  name = %[1]q
+
+  reference_data_sources {
+    table_name = %[2]q
+
+    s3 {
+      bucket_arn = "${aws_s3_bucket.test.arn}"
+      file_key   = "test_file_key"
+      role_arn   = "${aws_iam_role.test.arn}"
+    }
+
+    schema {
+      record_columns {
+        name     = "COLUMN_1"
+        sql_type = "INTEGER"
+      }
+
+      record_format {
+        mapping_parameters {
+          csv {
+            record_column_delimiter = ","
+            record_row_delimiter    = "\n"
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, tableName)
+}
+
+func testAccAWSKinesisAnalyticsApplicationConfigStartApplication(rName string, start bool) string {
+	return testAccAWSKinesisAnalyticsApplicationConfigKinesisStream(rName) + fmt.Sprintf(`
+resource "aws_kinesis_analytics_application" "test" {
+  name              = %[1]q
+  start_application = %[2]t
+
+  code = <<EOF
+CREATE OR REPLACE STREAM "DESTINATION_SQL_STREAM" ("COLUMN_1" VARCHAR(8));
+CREATE OR REPLACE PUMP "STREAM_PUMP" AS INSERT INTO "DESTINATION_SQL_STREAM"
+SELECT STREAM "COLUMN_1" FROM "SOURCE_SQL_STREAM_001";
+EOF
+
+  inputs {
+    name_prefix = "SOURCE_SQL_STREAM"
+
+    kinesis_stream {
+      resource_arn = "${aws_kinesis_stream.test.arn}"
+      role_arn     = "${aws_iam_role.test.arn}"
+    }
+
+    schema {
+      record_columns {
+        mapping  = "$.test"
+        name     = "COLUMN_1"
+        sql_type = "VARCHAR(8)"
+      }
+
+      record_format {
+        mapping_parameters {
+          json {
+            record_row_path = "$"
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = ["aws_iam_role_policy.test"]
+}
+`, rName, start)
+}
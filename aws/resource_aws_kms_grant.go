@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/kms"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -203,8 +204,8 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	// The grant sometimes contains principals that identified by their unique id: "AROAJYCVIVUZIMTXXXXX"
-	// instead of "arn:aws:...", in this case don't update the state file
-	if strings.HasPrefix(*grant.GranteePrincipal, "arn:aws") {
+	// instead of an ARN, in this case don't update the state file
+	if isArn(*grant.GranteePrincipal) {
 		d.Set("grantee_principal", grant.GranteePrincipal)
 	} else {
 		log.Printf(
@@ -213,7 +214,7 @@ func resourceAwsKmsGrantRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if grant.RetiringPrincipal != nil {
-		if strings.HasPrefix(*grant.RetiringPrincipal, "arn:aws") {
+		if isArn(*grant.RetiringPrincipal) {
 			d.Set("retiring_principal", grant.RetiringPrincipal)
 		} else {
 			log.Printf(
@@ -523,24 +524,20 @@ func flattenKmsGrantConstraints(constraint *kms.GrantConstraints) *schema.Set {
 }
 
 func decodeKmsGrantId(id string) (string, string, error) {
-	if strings.HasPrefix(id, "arn:aws") {
-		arn_parts := strings.Split(id, "/")
-		if len(arn_parts) != 2 {
-			return "", "", fmt.Errorf("unexpected format of ARN (%q), expected KeyID:GrantID", id)
-		}
-		arn_prefix := arn_parts[0]
-		parts := strings.Split(arn_parts[1], ":")
-		if len(parts) != 2 {
-			return "", "", fmt.Errorf("unexpected format of ID (%q), expected KeyID:GrantID", id)
-		}
-		return fmt.Sprintf("%s/%s", arn_prefix, parts[0]), parts[1], nil
-	} else {
-		parts := strings.Split(id, ":")
+	if keyArn, err := arn.Parse(id); err == nil {
+		parts := strings.Split(keyArn.Resource, ":")
 		if len(parts) != 2 {
-			return "", "", fmt.Errorf("unexpected format of ID (%q), expected KeyID:GrantID", id)
+			return "", "", fmt.Errorf("unexpected format of ARN (%q), expected KeyID:GrantID", id)
 		}
-		return parts[0], parts[1], nil
+		keyArn.Resource = parts[0]
+		return keyArn.String(), parts[1], nil
+	}
+
+	parts := strings.Split(id, ":")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("unexpected format of ID (%q), expected KeyID:GrantID", id)
 	}
+	return parts[0], parts[1], nil
 }
 
 // Custom error, so we don't have to rely on
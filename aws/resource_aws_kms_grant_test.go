@@ -9,6 +9,54 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestDecodeKmsGrantId(t *testing.T) {
+	cases := []struct {
+		ID          string
+		KeyID       string
+		GrantID     string
+		ErrExpected bool
+	}{
+		{
+			ID:      "1234abcd-12ab-34cd-56ef-1234567890ab:grant",
+			KeyID:   "1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantID: "grant",
+		},
+		{
+			ID:      "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab:grant",
+			KeyID:   "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantID: "grant",
+		},
+		{
+			ID:      "arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab:grant",
+			KeyID:   "arn:aws-cn:kms:cn-north-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			GrantID: "grant",
+		},
+		{
+			ID:          "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ErrExpected: true,
+		},
+		{
+			ID:          "arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		keyID, grantID, err := decodeKmsGrantId(tc.ID)
+		if tc.ErrExpected && err == nil {
+			t.Errorf("expected error for ID %q", tc.ID)
+			continue
+		}
+		if !tc.ErrExpected && err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.ID, err)
+			continue
+		}
+		if keyID != tc.KeyID || grantID != tc.GrantID {
+			t.Errorf("expected (%q, %q) for ID %q, got (%q, %q)", tc.KeyID, tc.GrantID, tc.ID, keyID, grantID)
+		}
+	}
+}
+
 func TestAccAWSKmsGrant_Basic(t *testing.T) {
 	timestamp := time.Now().Format(time.RFC1123)
 
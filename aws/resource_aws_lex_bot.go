@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexBot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexBotCreate,
+		Read:   resourceAwsLexBotRead,
+		Update: resourceAwsLexBotUpdate,
+		Delete: resourceAwsLexBotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"abort_statement": lexStatementRequiredSchema(),
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"child_directed": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"clarification_prompt": lexPromptSchema(),
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"failure_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"idle_session_ttl_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(60, 86400),
+			},
+			"intent": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"intent_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateLexName(1, 100),
+						},
+						"intent_version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  lexmodelbuildingservice.LocaleEnUs,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.LocaleDeDe,
+					lexmodelbuildingservice.LocaleEnGb,
+					lexmodelbuildingservice.LocaleEnUs,
+				}, false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName(2, 50),
+			},
+			"process_behavior": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  lexmodelbuildingservice.ProcessBehaviorSave,
+				ValidateFunc: validation.StringInSlice([]string{
+					lexmodelbuildingservice.ProcessBehaviorBuild,
+					lexmodelbuildingservice.ProcessBehaviorSave,
+				}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"voice_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsLexBotCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexBot(d)
+
+	log.Printf("[DEBUG] Creating Lex Bot: %s", input)
+	if err := putLexBot(conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating Lex Bot (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if err := waitForLexBotBuild(conn, d, schema.TimeoutCreate); err != nil {
+		return err
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+		Name:           aws.String(d.Id()),
+		VersionOrAlias: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Bot (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	version, err := lexBotLatestVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Lex Bot (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("arn", meta.(*AWSClient).regionalArn("lex", fmt.Sprintf("bot:%s", d.Id())))
+	d.Set("checksum", output.Checksum)
+	d.Set("child_directed", output.ChildDirected)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("failure_reason", output.FailureReason)
+	d.Set("idle_session_ttl_in_seconds", output.IdleSessionTTLInSeconds)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("locale", output.Locale)
+	d.Set("name", output.Name)
+	d.Set("status", output.Status)
+	d.Set("version", version)
+	d.Set("voice_id", output.VoiceId)
+
+	if err := d.Set("abort_statement", flattenLexStatement(output.AbortStatement)); err != nil {
+		return fmt.Errorf("error setting abort_statement: %s", err)
+	}
+
+	if err := d.Set("clarification_prompt", flattenLexPrompt(output.ClarificationPrompt)); err != nil {
+		return fmt.Errorf("error setting clarification_prompt: %s", err)
+	}
+
+	if err := d.Set("intent", flattenLexIntents(output.Intents)); err != nil {
+		return fmt.Errorf("error setting intent: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexBotUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	// The checksum of $LATEST must be given to update it
+	input := expandLexBot(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+
+	log.Printf("[DEBUG] Updating Lex Bot: %s", input)
+	if err := putLexBot(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if err := waitForLexBotBuild(conn, d, schema.TimeoutUpdate); err != nil {
+		return err
+	}
+
+	return resourceAwsLexBotRead(d, meta)
+}
+
+func resourceAwsLexBotDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	log.Printf("[DEBUG] Deleting Lex Bot: %s", d.Id())
+	// Bots cannot be deleted while aliases being deleted still use them
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteBot(&lexmodelbuildingservice.DeleteBotInput{
+			Name: aws.String(d.Id()),
+		})
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Bot (%s): %s", d.Id(), err)
+	}
+
+	if _, err := lexBotDeletedWaiter.waitForResource(d, schema.TimeoutDelete, lexBotStatus(conn, d.Id())); err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForLexBotBuild waits for the build of a bot to complete, returning the
+// reason of its failure when it fails.
+func waitForLexBotBuild(conn *lexmodelbuildingservice.LexModelBuildingService, d *schema.ResourceData, timeoutKey string) error {
+	v, err := lexBotBuiltWaiter.waitForResource(d, timeoutKey, lexBotStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for Lex Bot (%s) build: %s", d.Id(), err)
+	}
+
+	if bot, ok := v.(*lexmodelbuildingservice.GetBotOutput); ok && aws.StringValue(bot.Status) == lexmodelbuildingservice.StatusFailed {
+		return fmt.Errorf("error building Lex Bot (%s): %s", d.Id(), aws.StringValue(bot.FailureReason))
+	}
+
+	return nil
+}
+
+// putLexBot creates or updates a bot, retrying while concurrent changes to
+// it, or to its intents, are in progress.
+func putLexBot(conn *lexmodelbuildingservice.LexModelBuildingService, input *lexmodelbuildingservice.PutBotInput, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.PutBot(input)
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func lexBotLatestVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	var versions []string
+	input := &lexmodelbuildingservice.GetBotVersionsInput{
+		Name: aws.String(name),
+	}
+	err := conn.GetBotVersionsPages(input, func(page *lexmodelbuildingservice.GetBotVersionsOutput, lastPage bool) bool {
+		for _, bot := range page.Bots {
+			versions = append(versions, aws.StringValue(bot.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return lexLatestVersion(versions), nil
+}
+
+func expandLexBot(d *schema.ResourceData) *lexmodelbuildingservice.PutBotInput {
+	input := &lexmodelbuildingservice.PutBotInput{
+		AbortStatement:          expandLexStatement(d.Get("abort_statement").([]interface{})),
+		ChildDirected:           aws.Bool(d.Get("child_directed").(bool)),
+		ClarificationPrompt:     expandLexPrompt(d.Get("clarification_prompt").([]interface{})),
+		CreateVersion:           aws.Bool(d.Get("create_version").(bool)),
+		Description:             aws.String(d.Get("description").(string)),
+		IdleSessionTTLInSeconds: aws.Int64(int64(d.Get("idle_session_ttl_in_seconds").(int))),
+		Locale:                  aws.String(d.Get("locale").(string)),
+		Name:                    aws.String(d.Get("name").(string)),
+		ProcessBehavior:         aws.String(d.Get("process_behavior").(string)),
+	}
+
+	for _, v := range d.Get("intent").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		input.Intents = append(input.Intents, &lexmodelbuildingservice.Intent{
+			IntentName:    aws.String(m["intent_name"].(string)),
+			IntentVersion: aws.String(m["intent_version"].(string)),
+		})
+	}
+
+	if v, ok := d.GetOk("voice_id"); ok {
+		input.VoiceId = aws.String(v.(string))
+	}
+
+	return input
+}
+
+func flattenLexIntents(intents []*lexmodelbuildingservice.Intent) []interface{} {
+	result := make([]interface{}, 0, len(intents))
+	for _, intent := range intents {
+		result = append(result, map[string]interface{}{
+			"intent_name":    aws.StringValue(intent.IntentName),
+			"intent_version": aws.StringValue(intent.IntentVersion),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDecodeLexBotAliasID(t *testing.T) {
+	cases := []struct {
+		ID          string
+		BotName     string
+		Name        string
+		ErrExpected bool
+	}{
+		{
+			ID:          "OrderFlowers:Production",
+			BotName:     "OrderFlowers",
+			Name:        "Production",
+			ErrExpected: false,
+		},
+		{
+			ID:          "OrderFlowers",
+			ErrExpected: true,
+		},
+		{
+			ID:          "OrderFlowers:",
+			ErrExpected: true,
+		},
+		{
+			ID:          "OrderFlowers:Production:Extra",
+			ErrExpected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		botName, name, err := decodeLexBotAliasID(tc.ID)
+		if tc.ErrExpected && err == nil {
+			t.Errorf("expected error for ID %q", tc.ID)
+			continue
+		}
+		if !tc.ErrExpected && err != nil {
+			t.Errorf("unexpected error for ID %q: %s", tc.ID, err)
+			continue
+		}
+		if botName != tc.BotName || name != tc.Name {
+			t.Errorf("expected (%q, %q) for ID %q, got (%q, %q)", tc.BotName, tc.Name, tc.ID, botName, name)
+		}
+	}
+}
+
+func TestAccAWSLexBotAlias_basic(t *testing.T) {
+	var alias lexmodelbuildingservice.GetBotAliasOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot_alias.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotAliasConfig(rName, "original"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "original"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_name", "aws_lex_bot.test", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "bot_version", "aws_lex_bot.test", "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSLexBotAliasConfig(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotAliasExists(resourceName, &alias),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexBotAliasDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot_alias" {
+			continue
+		}
+
+		botName, name, err := decodeLexBotAliasID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot Alias %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexBotAliasExists(n string, alias *lexmodelbuildingservice.GetBotAliasOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot Alias ID is set")
+		}
+
+		botName, name, err := decodeLexBotAliasID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+		if err != nil {
+			return err
+		}
+
+		*alias = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexBotAliasConfig(rName, description string) string {
+	return testAccAWSLexBotConfig(rName, "test", "BUILD", true) + fmt.Sprintf(`
+resource "aws_lex_bot_alias" "test" {
+  bot_name    = "${aws_lex_bot.test.name}"
+  bot_version = "${aws_lex_bot.test.version}"
+  name        = %[1]q
+  description = %[2]q
+}
+`, rName, description)
+}
@@ -0,0 +1,202 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexBot_basic(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotConfig(rName, "original", lexmodelbuildingservice.ProcessBehaviorSave, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "original"),
+					resource.TestCheckResourceAttr(resourceName, "child_directed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "idle_session_ttl_in_seconds", "300"),
+					resource.TestCheckResourceAttr(resourceName, "intent.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locale", lexmodelbuildingservice.LocaleEnUs),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusNotBuilt),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version", "process_behavior"},
+			},
+			{
+				Config: testAccAWSLexBotConfig(rName, "updated", lexmodelbuildingservice.ProcessBehaviorSave, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_build(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotConfig(rName, "original", lexmodelbuildingservice.ProcessBehaviorBuild, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+				),
+			},
+			{
+				Config: testAccAWSLexBotConfig(rName, "updated", lexmodelbuildingservice.ProcessBehaviorBuild, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "status", lexmodelbuildingservice.StatusReady),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexBot_createVersion(t *testing.T) {
+	var bot lexmodelbuildingservice.GetBotOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_bot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexBotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexBotConfig(rName, "original", lexmodelbuildingservice.ProcessBehaviorBuild, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLexBotConfig(rName, "updated", lexmodelbuildingservice.ProcessBehaviorBuild, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexBotExists(resourceName, &bot),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexBotDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_bot" {
+			continue
+		}
+
+		_, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Bot %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexBotExists(n string, bot *lexmodelbuildingservice.GetBotOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Bot ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(rs.Primary.ID),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			return err
+		}
+
+		*bot = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexBotConfig(rName, description, processBehavior string, createVersion bool) string {
+	return testAccAWSLexIntentConfig(rName, "test", true) + fmt.Sprintf(`
+resource "aws_lex_bot" "test" {
+  name             = %[1]q
+  description      = %[2]q
+  child_directed   = false
+  process_behavior = %[3]q
+  create_version   = %[4]t
+
+  abort_statement {
+    message {
+      content      = "Sorry, I am not able to assist at this time."
+      content_type = "PlainText"
+    }
+  }
+
+  clarification_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "I didn't understand you, what would you like to do?"
+      content_type = "PlainText"
+    }
+  }
+
+  intent {
+    intent_name    = "${aws_lex_intent.test.name}"
+    intent_version = "${aws_lex_intent.test.version}"
+  }
+}
+`, rName, description, processBehavior, createVersion)
+}
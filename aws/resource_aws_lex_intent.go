@@ -0,0 +1,460 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsLexIntent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsLexIntentCreate,
+		Read:   resourceAwsLexIntentRead,
+		Update: resourceAwsLexIntentUpdate,
+		Delete: resourceAwsLexIntentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"conclusion_statement": lexStatementSchema(),
+			"confirmation_prompt":  lexPromptSchema(),
+			"create_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"dialog_code_hook": lexCodeHookSchema(),
+			"follow_up_prompt": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prompt":              lexPromptRequiredSchema(),
+						"rejection_statement": lexStatementRequiredSchema(),
+					},
+				},
+			},
+			"fulfillment_activity": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code_hook": lexCodeHookSchema(),
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.FulfillmentActivityTypeCodeHook,
+								lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent,
+							}, false),
+						},
+					},
+				},
+			},
+			"last_updated_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLexName(1, 100),
+			},
+			"parent_intent_signature": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rejection_statement": lexStatementSchema(),
+			"sample_utterances": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 1500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 200),
+				},
+			},
+			"slot": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 200),
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"response_card": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 50000),
+						},
+						"sample_utterances": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 10,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 200),
+							},
+						},
+						"slot_constraint": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								lexmodelbuildingservice.SlotConstraintOptional,
+								lexmodelbuildingservice.SlotConstraintRequired,
+							}, false),
+						},
+						"slot_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"slot_type_version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 64),
+						},
+						"value_elicitation_prompt": lexPromptSchema(),
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func lexPromptRequiredSchema() *schema.Schema {
+	s := lexPromptSchema()
+	s.Optional = false
+	s.Required = true
+	return s
+}
+
+func lexStatementRequiredSchema() *schema.Schema {
+	s := lexStatementSchema()
+	s.Optional = false
+	s.Required = true
+	return s
+}
+
+func resourceAwsLexIntentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+	name := d.Get("name").(string)
+
+	input := expandLexIntent(d)
+
+	log.Printf("[DEBUG] Creating Lex Intent: %s", input)
+	if err := putLexIntent(conn, input, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error creating Lex Intent (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+		Name:    aws.String(d.Id()),
+		Version: aws.String(lexVersionLatest),
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		log.Printf("[WARN] Lex Intent (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	version, err := lexIntentLatestVersion(conn, d.Id())
+	if err != nil {
+		return fmt.Errorf("error reading Lex Intent (%s) versions: %s", d.Id(), err)
+	}
+
+	d.Set("arn", meta.(*AWSClient).regionalArn("lex", fmt.Sprintf("intent:%s", d.Id())))
+	d.Set("checksum", output.Checksum)
+	d.Set("created_date", aws.TimeValue(output.CreatedDate).Format(time.RFC3339))
+	d.Set("description", output.Description)
+	d.Set("last_updated_date", aws.TimeValue(output.LastUpdatedDate).Format(time.RFC3339))
+	d.Set("name", output.Name)
+	d.Set("parent_intent_signature", output.ParentIntentSignature)
+	d.Set("version", version)
+
+	if err := d.Set("conclusion_statement", flattenLexStatement(output.ConclusionStatement)); err != nil {
+		return fmt.Errorf("error setting conclusion_statement: %s", err)
+	}
+
+	if err := d.Set("confirmation_prompt", flattenLexPrompt(output.ConfirmationPrompt)); err != nil {
+		return fmt.Errorf("error setting confirmation_prompt: %s", err)
+	}
+
+	if err := d.Set("dialog_code_hook", flattenLexCodeHook(output.DialogCodeHook)); err != nil {
+		return fmt.Errorf("error setting dialog_code_hook: %s", err)
+	}
+
+	if err := d.Set("follow_up_prompt", flattenLexFollowUpPrompt(output.FollowUpPrompt)); err != nil {
+		return fmt.Errorf("error setting follow_up_prompt: %s", err)
+	}
+
+	if err := d.Set("fulfillment_activity", flattenLexFulfillmentActivity(output.FulfillmentActivity)); err != nil {
+		return fmt.Errorf("error setting fulfillment_activity: %s", err)
+	}
+
+	if err := d.Set("rejection_statement", flattenLexStatement(output.RejectionStatement)); err != nil {
+		return fmt.Errorf("error setting rejection_statement: %s", err)
+	}
+
+	if err := d.Set("sample_utterances", flattenStringList(output.SampleUtterances)); err != nil {
+		return fmt.Errorf("error setting sample_utterances: %s", err)
+	}
+
+	if err := d.Set("slot", flattenLexSlots(output.Slots)); err != nil {
+		return fmt.Errorf("error setting slot: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsLexIntentUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	// The checksum of $LATEST must be given to update it
+	input := expandLexIntent(d)
+	input.Checksum = aws.String(d.Get("checksum").(string))
+
+	log.Printf("[DEBUG] Updating Lex Intent: %s", input)
+	if err := putLexIntent(conn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsLexIntentRead(d, meta)
+}
+
+func resourceAwsLexIntentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).lexmodelconn
+
+	log.Printf("[DEBUG] Deleting Lex Intent: %s", d.Id())
+	// Intents cannot be deleted while bots being deleted still use them
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.DeleteIntent(&lexmodelbuildingservice.DeleteIntentInput{
+			Name: aws.String(d.Id()),
+		})
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") || isAWSErr(err, lexmodelbuildingservice.ErrCodeResourceInUseException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Lex Intent (%s): %s", d.Id(), err)
+	}
+
+	if _, err := lexDeletedWaiter.waitForResource(d, schema.TimeoutDelete, lexIntentStatus(conn, d.Id())); err != nil {
+		return fmt.Errorf("error waiting for Lex Intent (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// putLexIntent creates or updates an intent, retrying while concurrent
+// changes to it are in progress.
+func putLexIntent(conn *lexmodelbuildingservice.LexModelBuildingService, input *lexmodelbuildingservice.PutIntentInput, timeout time.Duration) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		_, err := conn.PutIntent(input)
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeConflictException, "") {
+			return resource.RetryableError(err)
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func lexIntentLatestVersion(conn *lexmodelbuildingservice.LexModelBuildingService, name string) (string, error) {
+	var versions []string
+	input := &lexmodelbuildingservice.GetIntentVersionsInput{
+		Name: aws.String(name),
+	}
+	err := conn.GetIntentVersionsPages(input, func(page *lexmodelbuildingservice.GetIntentVersionsOutput, lastPage bool) bool {
+		for _, intent := range page.Intents {
+			versions = append(versions, aws.StringValue(intent.Version))
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return lexLatestVersion(versions), nil
+}
+
+func expandLexIntent(d *schema.ResourceData) *lexmodelbuildingservice.PutIntentInput {
+	input := &lexmodelbuildingservice.PutIntentInput{
+		ConclusionStatement: expandLexStatement(d.Get("conclusion_statement").([]interface{})),
+		ConfirmationPrompt:  expandLexPrompt(d.Get("confirmation_prompt").([]interface{})),
+		CreateVersion:       aws.Bool(d.Get("create_version").(bool)),
+		Description:         aws.String(d.Get("description").(string)),
+		DialogCodeHook:      expandLexCodeHook(d.Get("dialog_code_hook").([]interface{})),
+		Name:                aws.String(d.Get("name").(string)),
+		RejectionStatement:  expandLexStatement(d.Get("rejection_statement").([]interface{})),
+	}
+
+	if v := d.Get("follow_up_prompt").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		input.FollowUpPrompt = &lexmodelbuildingservice.FollowUpPrompt{
+			Prompt:             expandLexPrompt(m["prompt"].([]interface{})),
+			RejectionStatement: expandLexStatement(m["rejection_statement"].([]interface{})),
+		}
+	}
+
+	if v := d.Get("fulfillment_activity").([]interface{}); len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		input.FulfillmentActivity = &lexmodelbuildingservice.FulfillmentActivity{
+			CodeHook: expandLexCodeHook(m["code_hook"].([]interface{})),
+			Type:     aws.String(m["type"].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("parent_intent_signature"); ok {
+		input.ParentIntentSignature = aws.String(v.(string))
+	}
+
+	if v := d.Get("sample_utterances").(*schema.Set); v.Len() > 0 {
+		input.SampleUtterances = expandStringSet(v)
+	}
+
+	for _, v := range d.Get("slot").(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		slot := &lexmodelbuildingservice.Slot{
+			Name:                   aws.String(m["name"].(string)),
+			SlotConstraint:         aws.String(m["slot_constraint"].(string)),
+			SlotType:               aws.String(m["slot_type"].(string)),
+			ValueElicitationPrompt: expandLexPrompt(m["value_elicitation_prompt"].([]interface{})),
+		}
+		if v, ok := m["description"].(string); ok && v != "" {
+			slot.Description = aws.String(v)
+		}
+		if v, ok := m["priority"].(int); ok && v != 0 {
+			slot.Priority = aws.Int64(int64(v))
+		}
+		if v, ok := m["response_card"].(string); ok && v != "" {
+			slot.ResponseCard = aws.String(v)
+		}
+		if v := m["sample_utterances"].([]interface{}); len(v) > 0 {
+			slot.SampleUtterances = expandStringList(v)
+		}
+		if v, ok := m["slot_type_version"].(string); ok && v != "" {
+			slot.SlotTypeVersion = aws.String(v)
+		}
+		input.Slots = append(input.Slots, slot)
+	}
+
+	return input
+}
+
+func flattenLexFollowUpPrompt(prompt *lexmodelbuildingservice.FollowUpPrompt) []interface{} {
+	if prompt == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prompt":              flattenLexPrompt(prompt.Prompt),
+			"rejection_statement": flattenLexStatement(prompt.RejectionStatement),
+		},
+	}
+}
+
+func flattenLexFulfillmentActivity(activity *lexmodelbuildingservice.FulfillmentActivity) []interface{} {
+	if activity == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"code_hook": flattenLexCodeHook(activity.CodeHook),
+			"type":      aws.StringValue(activity.Type),
+		},
+	}
+}
+
+func flattenLexSlots(slots []*lexmodelbuildingservice.Slot) []interface{} {
+	result := make([]interface{}, 0, len(slots))
+	for _, slot := range slots {
+		result = append(result, map[string]interface{}{
+			"description":              aws.StringValue(slot.Description),
+			"name":                     aws.StringValue(slot.Name),
+			"priority":                 int(aws.Int64Value(slot.Priority)),
+			"response_card":            aws.StringValue(slot.ResponseCard),
+			"sample_utterances":        flattenStringList(slot.SampleUtterances),
+			"slot_constraint":          aws.StringValue(slot.SlotConstraint),
+			"slot_type":                aws.StringValue(slot.SlotType),
+			"slot_type_version":        aws.StringValue(slot.SlotTypeVersion),
+			"value_elicitation_prompt": flattenLexPrompt(slot.ValueElicitationPrompt),
+		})
+	}
+
+	return result
+}
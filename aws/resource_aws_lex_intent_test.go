@@ -0,0 +1,237 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexIntent_basic(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexIntentConfig(rName, "original", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "original"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "fulfillment_activity.0.type", lexmodelbuildingservice.FulfillmentActivityTypeReturnIntent),
+					resource.TestCheckResourceAttr(resourceName, "sample_utterances.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+			{
+				Config: testAccAWSLexIntentConfig(rName, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_createVersion(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexIntentConfig(rName, "original", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLexIntentConfig(rName, "updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexIntent_slots(t *testing.T) {
+	var intent lexmodelbuildingservice.GetIntentOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_intent.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexIntentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexIntentConfigSlots(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexIntentExists(resourceName, &intent),
+					resource.TestCheckResourceAttr(resourceName, "slot.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "confirmation_prompt.0.max_attempts", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rejection_statement.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexIntentDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_intent" {
+			continue
+		}
+
+		_, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Intent %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexIntentExists(n string, intent *lexmodelbuildingservice.GetIntentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Intent ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			return err
+		}
+
+		*intent = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexIntentConfig(rName, description string, createVersion bool) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name           = %[1]q
+  description    = %[2]q
+  create_version = %[3]t
+
+  sample_utterances = [
+    "I would like to order flowers",
+    "I want flowers",
+  ]
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+}
+`, rName, description, createVersion)
+}
+
+func testAccAWSLexIntentConfigSlots(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lex_intent" "test" {
+  name = %[1]q
+
+  sample_utterances = [
+    "I would like to pick up flowers",
+  ]
+
+  confirmation_prompt {
+    max_attempts = 2
+
+    message {
+      content      = "Okay, your {FlowerType} will be ready for pickup. Does this sound okay?"
+      content_type = "PlainText"
+    }
+  }
+
+  rejection_statement {
+    message {
+      content      = "Okay, I will not place your order."
+      content_type = "PlainText"
+    }
+  }
+
+  fulfillment_activity {
+    type = "ReturnIntent"
+  }
+
+  slot {
+    name              = "FlowerType"
+    description       = "The type of flowers to pick up"
+    priority          = 1
+    slot_constraint   = "Required"
+    slot_type         = "AMAZON.Color"
+    sample_utterances = ["I would like to order {FlowerType}"]
+
+    value_elicitation_prompt {
+      max_attempts = 2
+
+      message {
+        content      = "What type of flowers would you like to order?"
+        content_type = "PlainText"
+      }
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,152 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSLexSlotType_basic(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexSlotTypeConfig(rName, "original", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "original"),
+					resource.TestCheckResourceAttr(resourceName, "enumeration_value.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "value_selection_strategy", lexmodelbuildingservice.SlotValueSelectionStrategyOriginalValue),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+					resource.TestCheckResourceAttrSet(resourceName, "checksum"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated_date"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"create_version"},
+			},
+			{
+				Config: testAccAWSLexSlotTypeConfig(rName, "updated", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", lexVersionLatest),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSLexSlotType_createVersion(t *testing.T) {
+	var slotType lexmodelbuildingservice.GetSlotTypeOutput
+	rName := "tf_acc_test_" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	resourceName := "aws_lex_slot_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSLexSlotTypeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSLexSlotTypeConfig(rName, "original", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccAWSLexSlotTypeConfig(rName, "updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSLexSlotTypeExists(resourceName, &slotType),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAWSLexSlotTypeDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_lex_slot_type" {
+			continue
+		}
+
+		_, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Lex Slot Type %q still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckAWSLexSlotTypeExists(n string, slotType *lexmodelbuildingservice.GetSlotTypeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Lex Slot Type ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).lexmodelconn
+
+		output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(rs.Primary.ID),
+			Version: aws.String(lexVersionLatest),
+		})
+		if err != nil {
+			return err
+		}
+
+		*slotType = *output
+
+		return nil
+	}
+}
+
+func testAccAWSLexSlotTypeConfig(rName, description string, createVersion bool) string {
+	return fmt.Sprintf(`
+resource "aws_lex_slot_type" "test" {
+  name           = %[1]q
+  description    = %[2]q
+  create_version = %[3]t
+
+  enumeration_value {
+    value    = "lilies"
+    synonyms = ["Lirium", "Martagon"]
+  }
+}
+`, rName, description, createVersion)
+}
@@ -21,6 +21,10 @@ func resourceAwsOpsworksApplication() *schema.Resource {
 		Read:   resourceAwsOpsworksApplicationRead,
 		Update: resourceAwsOpsworksApplicationUpdate,
 		Delete: resourceAwsOpsworksApplicationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -272,9 +276,16 @@ func resourceAwsOpsworksApplicationRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
+	if len(resp.Apps) == 0 {
+		log.Printf("[INFO] App not found: %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
 	app := resp.Apps[0]
 
 	d.Set("name", app.Name)
+	d.Set("short_name", app.Shortname)
 	d.Set("stack_id", app.StackId)
 	d.Set("type", app.Type)
 	d.Set("description", app.Description)
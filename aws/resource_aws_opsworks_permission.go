@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +20,9 @@ func resourceAwsOpsworksPermission() *schema.Resource {
 		Update: resourceAwsOpsworksSetPermission,
 		Delete: resourceAwsOpsworksPermissionDelete,
 		Read:   resourceAwsOpsworksPermissionRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksPermissionImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allow_ssh": {
@@ -59,6 +64,22 @@ func resourceAwsOpsworksPermissionDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceAwsOpsworksPermissionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <stack-id>/<user-arn>", d.Id())
+	}
+
+	stackId := idParts[0]
+	userArn := idParts[1]
+
+	d.Set("stack_id", stackId)
+	d.Set("user_arn", userArn)
+	d.SetId(userArn + stackId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksPermissionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).opsworksconn
 
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +19,9 @@ func resourceAwsOpsworksRdsDbInstance() *schema.Resource {
 		Update: resourceAwsOpsworksRdsDbInstanceUpdate,
 		Delete: resourceAwsOpsworksRdsDbInstanceDeregister,
 		Read:   resourceAwsOpsworksRdsDbInstanceRead,
+		Importer: &schema.ResourceImporter{
+			State: resourceAwsOpsworksRdsDbInstanceImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"stack_id": {
@@ -128,6 +132,25 @@ func resourceAwsOpsworksRdsDbInstanceDeregister(d *schema.ResourceData, meta int
 	return nil
 }
 
+// resourceAwsOpsworksRdsDbInstanceImport imports a registered RDS DB instance
+// by its stack ID and ARN. The password can't be read back, so db_password is
+// left unset.
+func resourceAwsOpsworksRdsDbInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("Unexpected format of ID (%q), expected <stack-id>/<rds-db-instance-arn>", d.Id())
+	}
+
+	stackId := idParts[0]
+	arn := idParts[1]
+
+	d.Set("stack_id", stackId)
+	d.Set("rds_db_instance_arn", arn)
+	d.SetId(arn + stackId)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAwsOpsworksRdsDbInstanceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*AWSClient).opsworksconn
 
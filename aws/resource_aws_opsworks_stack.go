@@ -11,7 +11,6 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opsworks"
@@ -531,15 +530,9 @@ func resourceAwsOpsworksStackUpdate(d *schema.ResourceData, meta interface{}) er
 		req.Attributes["Color"] = aws.String(v.(string))
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Region:    meta.(*AWSClient).region,
-		Service:   "opsworks",
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("stack/%s/", d.Id()),
-	}
+	arn := meta.(*AWSClient).regionalArn("opsworks", fmt.Sprintf("stack/%s/", d.Id()))
 
-	if tagErr := setTagsOpsworks(client, d, arn.String()); tagErr != nil {
+	if tagErr := setTagsOpsworks(client, d, arn); tagErr != nil {
 		return tagErr
 	}
 
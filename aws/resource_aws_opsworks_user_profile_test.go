@@ -36,6 +36,11 @@ func TestAccAWSOpsworksUserProfile(t *testing.T) {
 					),
 				),
 			},
+			{
+				ResourceName:      "aws_opsworks_user_profile.user",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAwsOpsworksUserProfileUpdate(rName, updateRName),
 				Check: resource.ComposeTestCheckFunc(
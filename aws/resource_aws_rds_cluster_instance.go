@@ -207,7 +207,7 @@ func resourceAwsRDSClusterInstance() *schema.Resource {
 
 func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*AWSClient).rdsconn
-	tags := tagsFromMapRDS(d.Get("tags").(map[string]interface{}))
+	tags := tagsFromMapRDS(d.Get("tags_all").(map[string]interface{}))
 
 	createOpts := &rds.CreateDBInstanceInput{
 		DBInstanceClass:         aws.String(d.Get("instance_class").(string)),
@@ -290,18 +290,7 @@ func resourceAwsRDSClusterInstanceCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(*resp.DBInstance.DBInstanceIdentifier)
 
-	// reuse db_instance refresh func
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsRdsClusterInstanceCreateUpdatePendingStates,
-		Target:     []string{"available"},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
-	}
-
-	// Wait, catching any errors
-	_, err = stateConf.WaitForState()
+	_, err = rdsClusterInstanceAvailableWaiter.waitForResource(d, schema.TimeoutCreate, rdsDbInstanceStatus(conn, d.Id()))
 	if err != nil {
 		return err
 	}
@@ -490,18 +479,7 @@ func resourceAwsRDSClusterInstanceUpdate(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err)
 		}
 
-		// reuse db_instance refresh func
-		stateConf := &resource.StateChangeConf{
-			Pending:    resourceAwsRdsClusterInstanceCreateUpdatePendingStates,
-			Target:     []string{"available"},
-			Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			MinTimeout: 10 * time.Second,
-			Delay:      30 * time.Second, // Wait 30 secs before starting
-		}
-
-		// Wait, catching any errors
-		_, err = stateConf.WaitForState()
+		_, err = rdsClusterInstanceAvailableWaiter.waitForResource(d, schema.TimeoutUpdate, rdsDbInstanceStatus(conn, d.Id()))
 		if err != nil {
 			return err
 		}
@@ -527,41 +505,11 @@ func resourceAwsRDSClusterInstanceDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	// re-uses db_instance refresh func
 	log.Println("[INFO] Waiting for RDS Cluster Instance to be destroyed")
-	stateConf := &resource.StateChangeConf{
-		Pending:    resourceAwsRdsClusterInstanceDeletePendingStates,
-		Target:     []string{},
-		Refresh:    resourceAwsDbInstanceStateRefreshFunc(d.Id(), conn),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second, // Wait 30 secs before starting
-	}
-
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := rdsClusterInstanceDeletedWaiter.waitForResource(d, schema.TimeoutDelete, rdsDbInstanceStatus(conn, d.Id())); err != nil {
 		return err
 	}
 
 	return nil
 
 }
-
-var resourceAwsRdsClusterInstanceCreateUpdatePendingStates = []string{
-	"backing-up",
-	"configuring-enhanced-monitoring",
-	"configuring-log-exports",
-	"creating",
-	"maintenance",
-	"modifying",
-	"rebooting",
-	"renaming",
-	"resetting-master-credentials",
-	"starting",
-	"upgrading",
-}
-
-var resourceAwsRdsClusterInstanceDeletePendingStates = []string{
-	"configuring-log-exports",
-	"modifying",
-	"deleting",
-}
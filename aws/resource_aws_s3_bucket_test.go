@@ -90,6 +90,51 @@ func TestAccAWSS3Bucket_importWithPolicy(t *testing.T) {
 	})
 }
 
+func TestAWSS3Bucket_offline(t *testing.T) {
+	f := newFakeAWS(t, "s3_bucket")
+	defer f.Close()
+
+	testFakeAWSResource(t, f, "aws_s3_bucket",
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"bucket": "tf-acc-fake-bucket",
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-bucket",
+				},
+			},
+			Check: map[string]string{
+				"id":                          "tf-acc-fake-bucket",
+				"arn":                         "arn:aws:s3:::tf-acc-fake-bucket",
+				"acl":                         "private",
+				"region":                      "us-west-2",
+				"bucket_regional_domain_name": "tf-acc-fake-bucket.s3.us-west-2.amazonaws.com",
+				"hosted_zone_id":              "Z3BJ6K6RIION7M",
+				"versioning.0.enabled":        "false",
+				"tags.%":                      "1",
+				"tags.Name":                   "tf-acc-bucket",
+			},
+		},
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"bucket": "tf-acc-fake-bucket",
+				"versioning": []interface{}{
+					map[string]interface{}{
+						"enabled": true,
+					},
+				},
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-bucket-updated",
+				},
+			},
+			Check: map[string]string{
+				"versioning.0.enabled": "true",
+				"tags.%":               "1",
+				"tags.Name":            "tf-acc-bucket-updated",
+			},
+		},
+	)
+}
+
 func TestAccAWSS3Bucket_basic(t *testing.T) {
 	rInt := acctest.RandInt()
 	arnRegexp := regexp.MustCompile(`^arn:aws[\w-]*:s3:::`)
@@ -0,0 +1,322 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerEndpointCreate,
+		Read:   resourceAwsSagemakerEndpointRead,
+		Update: resourceAwsSagemakerEndpointUpdate,
+		Delete: resourceAwsSagemakerEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"endpoint_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"production_variant": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_instance_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"desired_weight": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validateSagemakerVariantWeight,
+						},
+
+						"variant_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceAwsSagemakerEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &sagemaker.CreateEndpointInput{
+		EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+		EndpointName:       aws.String(name),
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapSageMaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Endpoint: %s", input)
+	_, err := conn.CreateEndpoint(input)
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Endpoint (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	err = waitForSagemakerEndpointInService(conn, d, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf("error waiting for SageMaker Endpoint (%s) creation: %s", d.Id(), err)
+	}
+
+	if v, ok := d.GetOk("production_variant"); ok {
+		if err := updateSagemakerEndpointWeightsAndCapacities(conn, d, schema.TimeoutCreate, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+
+	if isSagemakerEndpointNotFound(err) {
+		log.Printf("[WARN] SageMaker Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.EndpointArn)
+	d.Set("endpoint_config_name", output.EndpointConfigName)
+	d.Set("name", output.EndpointName)
+
+	// Only the weights of the configured variants are managed, the others
+	// keep those of the endpoint configuration
+	variants := flattenSagemakerProductionVariantSummaries(output.ProductionVariants, d.Get("production_variant").([]interface{}))
+	if err := d.Set("production_variant", variants); err != nil {
+		return fmt.Errorf("error setting production_variant: %s", err)
+	}
+
+	tags, err := tagsServiceSageMaker(conn).Get(aws.StringValue(output.EndpointArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := tagsServiceSageMaker(conn).UpdateResource(d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating SageMaker Endpoint (%s) tags: %s", d.Id(), err)
+	}
+
+	if d.HasChange("endpoint_config_name") {
+		input := &sagemaker.UpdateEndpointInput{
+			EndpointConfigName: aws.String(d.Get("endpoint_config_name").(string)),
+			EndpointName:       aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Endpoint: %s", input)
+		if _, err := conn.UpdateEndpoint(input); err != nil {
+			return fmt.Errorf("error updating SageMaker Endpoint (%s): %s", d.Id(), err)
+		}
+
+		err := waitForSagemakerEndpointInService(conn, d, schema.TimeoutUpdate)
+		if err != nil {
+			return fmt.Errorf("error waiting for SageMaker Endpoint (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	// A new endpoint configuration resets the variant weights, so they are
+	// applied again along with it
+	if v, ok := d.GetOk("production_variant"); ok && (d.HasChange("production_variant") || d.HasChange("endpoint_config_name")) {
+		if err := updateSagemakerEndpointWeightsAndCapacities(conn, d, schema.TimeoutUpdate, v.([]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceAwsSagemakerEndpointRead(d, meta)
+}
+
+func resourceAwsSagemakerEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(&sagemaker.DeleteEndpointInput{
+		EndpointName: aws.String(d.Id()),
+	})
+
+	if isSagemakerEndpointNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Endpoint (%s): %s", d.Id(), err)
+	}
+
+	_, err = sagemakerEndpointDeletedWaiter.waitForResource(d, schema.TimeoutDelete, sagemakerEndpointStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for SageMaker Endpoint (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func updateSagemakerEndpointWeightsAndCapacities(conn *sagemaker.SageMaker, d *schema.ResourceData, timeoutKey string, l []interface{}) error {
+	input := &sagemaker.UpdateEndpointWeightsAndCapacitiesInput{
+		DesiredWeightsAndCapacities: expandSagemakerDesiredWeightsAndCapacities(l),
+		EndpointName:                aws.String(d.Id()),
+	}
+
+	log.Printf("[DEBUG] Updating SageMaker Endpoint weights and capacities: %s", input)
+	if _, err := conn.UpdateEndpointWeightsAndCapacities(input); err != nil {
+		return fmt.Errorf("error updating SageMaker Endpoint (%s) weights and capacities: %s", d.Id(), err)
+	}
+
+	err := waitForSagemakerEndpointInService(conn, d, timeoutKey)
+	if err != nil {
+		return fmt.Errorf("error waiting for SageMaker Endpoint (%s) weights and capacities update: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// waitForSagemakerEndpointInService waits for the creation or update of an
+// endpoint to complete, returning the reason of its failure when it fails or
+// is rolled back.
+func waitForSagemakerEndpointInService(conn *sagemaker.SageMaker, d *schema.ResourceData, timeoutKey string) error {
+	v, err := sagemakerEndpointInServiceWaiter.waitForResource(d, timeoutKey, sagemakerEndpointStatus(conn, d.Id()))
+	if err != nil {
+		return err
+	}
+
+	endpoint, ok := v.(*sagemaker.DescribeEndpointOutput)
+	if !ok {
+		return nil
+	}
+
+	switch status := aws.StringValue(endpoint.EndpointStatus); status {
+	case sagemaker.EndpointStatusFailed, sagemaker.EndpointStatusRollingBack:
+		reason := aws.StringValue(endpoint.FailureReason)
+		if reason == "" {
+			reason = "no failure reason given"
+		}
+		return fmt.Errorf("endpoint status %s: %s", status, reason)
+	}
+
+	return nil
+}
+
+func expandSagemakerDesiredWeightsAndCapacities(l []interface{}) []*sagemaker.DesiredWeightAndCapacity {
+	result := make([]*sagemaker.DesiredWeightAndCapacity, 0, len(l))
+
+	for _, v := range l {
+		m := v.(map[string]interface{})
+
+		desired := &sagemaker.DesiredWeightAndCapacity{
+			DesiredWeight: aws.Float64(m["desired_weight"].(float64)),
+			VariantName:   aws.String(m["variant_name"].(string)),
+		}
+
+		if v, ok := m["desired_instance_count"].(int); ok && v > 0 {
+			desired.DesiredInstanceCount = aws.Int64(int64(v))
+		}
+
+		result = append(result, desired)
+	}
+
+	return result
+}
+
+// flattenSagemakerProductionVariantSummaries returns the weights and instance
+// counts of the variants named in configured, in the same order.
+func flattenSagemakerProductionVariantSummaries(summaries []*sagemaker.ProductionVariantSummary, configured []interface{}) []map[string]interface{} {
+	byName := make(map[string]*sagemaker.ProductionVariantSummary, len(summaries))
+	for _, summary := range summaries {
+		byName[aws.StringValue(summary.VariantName)] = summary
+	}
+
+	result := make([]map[string]interface{}, 0, len(configured))
+	for _, v := range configured {
+		name := v.(map[string]interface{})["variant_name"].(string)
+
+		summary, ok := byName[name]
+		if !ok {
+			continue
+		}
+
+		weight := summary.DesiredWeight
+		if weight == nil {
+			weight = summary.CurrentWeight
+		}
+		count := summary.DesiredInstanceCount
+		if count == nil {
+			count = summary.CurrentInstanceCount
+		}
+
+		result = append(result, map[string]interface{}{
+			"desired_instance_count": aws.Int64Value(count),
+			"desired_weight":         aws.Float64Value(weight),
+			"variant_name":           name,
+		})
+	}
+
+	return result
+}
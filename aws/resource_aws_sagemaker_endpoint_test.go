@@ -0,0 +1,229 @@
+package aws
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccAWSSagemakerEndpoint_basic(t *testing.T) {
+	var endpoint sagemaker.DescribeEndpointOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfig(rName, "aws_sagemaker_endpoint_configuration.test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfig(rName, "aws_sagemaker_endpoint_configuration.updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_config_name", "aws_sagemaker_endpoint_configuration.updated", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSSagemakerEndpoint_productionVariant(t *testing.T) {
+	var endpoint sagemaker.DescribeEndpointOutput
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "aws_sagemaker_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSagemakerEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSagemakerEndpointConfigProductionVariant(rName, 0.2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.0.variant_name", "variant-2"),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.0.desired_weight", "0.2"),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.0.desired_instance_count", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"production_variant"},
+			},
+			{
+				Config: testAccAWSSagemakerEndpointConfigProductionVariant(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSagemakerEndpointExists(resourceName, &endpoint),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "production_variant.0.desired_weight", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenSagemakerProductionVariantSummaries(t *testing.T) {
+	summaries := []*sagemaker.ProductionVariantSummary{
+		{
+			CurrentInstanceCount: aws.Int64(1),
+			CurrentWeight:        aws.Float64(1),
+			VariantName:          aws.String("variant-1"),
+		},
+		{
+			CurrentInstanceCount: aws.Int64(1),
+			CurrentWeight:        aws.Float64(1),
+			DesiredInstanceCount: aws.Int64(2),
+			DesiredWeight:        aws.Float64(0.5),
+			VariantName:          aws.String("variant-2"),
+		},
+	}
+
+	configured := []interface{}{
+		map[string]interface{}{"variant_name": "variant-2"},
+		map[string]interface{}{"variant_name": "variant-1"},
+		map[string]interface{}{"variant_name": "variant-3"},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"desired_instance_count": int64(2),
+			"desired_weight":         0.5,
+			"variant_name":           "variant-2",
+		},
+		{
+			"desired_instance_count": int64(1),
+			"desired_weight":         1.0,
+			"variant_name":           "variant-1",
+		},
+	}
+
+	result := flattenSagemakerProductionVariantSummaries(summaries, configured)
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got %#v, expected %#v", result, expected)
+	}
+
+	if result := flattenSagemakerProductionVariantSummaries(summaries, nil); len(result) != 0 {
+		t.Fatalf("expected no variants when none are configured, got %#v", result)
+	}
+}
+
+func testAccCheckAWSSagemakerEndpointDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_endpoint" {
+			continue
+		}
+
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+
+		if isSagemakerEndpointNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error reading SageMaker Endpoint (%s): %s", rs.Primary.ID, err)
+		}
+
+		if output != nil {
+			return fmt.Errorf("SageMaker Endpoint (%s) still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckAWSSagemakerEndpointExists(n string, endpoint *sagemaker.DescribeEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Endpoint ID is set")
+		}
+
+		conn := testAccProvider.Meta().(*AWSClient).sagemakerconn
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(rs.Primary.ID),
+		})
+		if err != nil {
+			return err
+		}
+
+		*endpoint = *output
+
+		return nil
+	}
+}
+
+func testAccAWSSagemakerEndpointConfigBase(rName string) string {
+	return testAccAWSSagemakerEndpointConfigurationConfig(rName, rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint_configuration" "updated" {
+  name = "%[1]s-updated"
+
+  production_variants {
+    variant_name           = "variant-1"
+    model_name             = "${aws_sagemaker_model.test.name}"
+    initial_instance_count = 2
+    instance_type          = "ml.t2.medium"
+  }
+}
+`, rName)
+}
+
+func testAccAWSSagemakerEndpointConfig(rName, endpointConfig string) string {
+	return testAccAWSSagemakerEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${%[2]s.name}"
+
+  tags {
+    Name = %[1]q
+  }
+}
+`, rName, endpointConfig)
+}
+
+func testAccAWSSagemakerEndpointConfigProductionVariant(rName string, weight float64) string {
+	return testAccAWSSagemakerEndpointConfigBase(rName) + fmt.Sprintf(`
+resource "aws_sagemaker_endpoint" "test" {
+  name                 = %[1]q
+  endpoint_config_name = "${aws_sagemaker_endpoint_configuration.test.name}"
+
+  production_variant {
+    variant_name   = "variant-2"
+    desired_weight = %[2]g
+  }
+}
+`, rName, weight)
+}
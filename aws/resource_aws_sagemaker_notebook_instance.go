@@ -0,0 +1,306 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerNotebookInstance() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceRead,
+		Update: resourceAwsSagemakerNotebookInstanceUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"direct_internet_access": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  sagemaker.DirectInternetAccessEnabled,
+				ValidateFunc: validation.StringInSlice([]string{
+					sagemaker.DirectInternetAccessDisabled,
+					sagemaker.DirectInternetAccessEnabled,
+				}, false),
+			},
+
+			"instance_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"lifecycle_config_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateArn,
+			},
+
+			"security_groups": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"tags": tagsSchema(),
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	name := d.Get("name").(string)
+
+	input := &sagemaker.CreateNotebookInstanceInput{
+		DirectInternetAccess: aws.String(d.Get("direct_internet_access").(string)),
+		InstanceType:         aws.String(d.Get("instance_type").(string)),
+		NotebookInstanceName: aws.String(name),
+		RoleArn:              aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("lifecycle_config_name"); ok {
+		input.LifecycleConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("security_groups"); ok {
+		input.SecurityGroupIds = expandStringSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("subnet_id"); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tags_all"); ok {
+		input.Tags = tagsFromMapSageMaker(v.(map[string]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Notebook Instance: %s", input)
+	_, err := conn.CreateNotebookInstance(input)
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Notebook Instance (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	_, err = sagemakerNotebookInstanceInServiceWaiter.waitForResource(d, schema.TimeoutCreate, sagemakerNotebookInstanceStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for SageMaker Notebook Instance (%s) creation: %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+
+	if isSagemakerNotebookInstanceNotFound(err) {
+		log.Printf("[WARN] SageMaker Notebook Instance (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.NotebookInstanceArn)
+	d.Set("direct_internet_access", output.DirectInternetAccess)
+	d.Set("instance_type", output.InstanceType)
+	d.Set("kms_key_id", output.KmsKeyId)
+	d.Set("lifecycle_config_name", output.NotebookInstanceLifecycleConfigName)
+	d.Set("name", output.NotebookInstanceName)
+	d.Set("role_arn", output.RoleArn)
+	d.Set("subnet_id", output.SubnetId)
+	d.Set("url", output.Url)
+
+	if err := d.Set("security_groups", flattenStringList(output.SecurityGroups)); err != nil {
+		return fmt.Errorf("error setting security_groups: %s", err)
+	}
+
+	tags, err := tagsServiceSageMaker(conn).Get(aws.StringValue(output.NotebookInstanceArn))
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags: %s", err)
+	}
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	if err := tagsServiceSageMaker(conn).UpdateResource(d, d.Get("arn").(string)); err != nil {
+		return fmt.Errorf("error updating SageMaker Notebook Instance (%s) tags: %s", d.Id(), err)
+	}
+
+	if d.HasChange("instance_type") || d.HasChange("lifecycle_config_name") || d.HasChange("role_arn") {
+		input := &sagemaker.UpdateNotebookInstanceInput{
+			InstanceType:         aws.String(d.Get("instance_type").(string)),
+			NotebookInstanceName: aws.String(d.Id()),
+			RoleArn:              aws.String(d.Get("role_arn").(string)),
+		}
+
+		if d.HasChange("lifecycle_config_name") {
+			if v, ok := d.GetOk("lifecycle_config_name"); ok {
+				input.LifecycleConfigName = aws.String(v.(string))
+			} else {
+				input.DisassociateLifecycleConfig = aws.Bool(true)
+			}
+		}
+
+		// Notebook instances can only be updated while they are stopped
+		if err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("error stopping SageMaker Notebook Instance (%s): %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Notebook Instance: %s", input)
+		if _, err := conn.UpdateNotebookInstance(input); err != nil {
+			return fmt.Errorf("error updating SageMaker Notebook Instance (%s): %s", d.Id(), err)
+		}
+
+		if _, err := sagemakerNotebookInstanceStoppedWaiter.waitForResource(d, schema.TimeoutUpdate, sagemakerNotebookInstanceStatus(conn, d.Id())); err != nil {
+			return fmt.Errorf("error waiting for SageMaker Notebook Instance (%s) update: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Starting SageMaker Notebook Instance: %s", d.Id())
+		if _, err := conn.StartNotebookInstance(&sagemaker.StartNotebookInstanceInput{
+			NotebookInstanceName: aws.String(d.Id()),
+		}); err != nil {
+			return fmt.Errorf("error starting SageMaker Notebook Instance (%s): %s", d.Id(), err)
+		}
+
+		if _, err := sagemakerNotebookInstanceInServiceWaiter.waitForResource(d, schema.TimeoutUpdate, sagemakerNotebookInstanceStatus(conn, d.Id())); err != nil {
+			return fmt.Errorf("error waiting for SageMaker Notebook Instance (%s) to start: %s", d.Id(), err)
+		}
+	}
+
+	return resourceAwsSagemakerNotebookInstanceRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	// Notebook instances can only be deleted while they are stopped
+	if err := stopSagemakerNotebookInstance(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if isSagemakerNotebookInstanceNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error stopping SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting SageMaker Notebook Instance: %s", d.Id())
+	_, err := conn.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+		NotebookInstanceName: aws.String(d.Id()),
+	})
+
+	if isSagemakerNotebookInstanceNotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Notebook Instance (%s): %s", d.Id(), err)
+	}
+
+	_, err = sagemakerNotebookInstanceDeletedWaiter.waitForResource(d, schema.TimeoutDelete, sagemakerNotebookInstanceStatus(conn, d.Id()))
+	if err != nil {
+		return fmt.Errorf("error waiting for SageMaker Notebook Instance (%s) deletion: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// stopSagemakerNotebookInstance stops a notebook instance, unless it is
+// already stopped or has failed, and waits for it to stop.
+func stopSagemakerNotebookInstance(conn *sagemaker.SageMaker, name string, timeout time.Duration) error {
+	output, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: aws.String(name),
+	})
+	if err != nil {
+		return err
+	}
+
+	status := aws.StringValue(output.NotebookInstanceStatus)
+	switch status {
+	case sagemaker.NotebookInstanceStatusFailed, sagemaker.NotebookInstanceStatusStopped:
+		return nil
+	case sagemaker.NotebookInstanceStatusPending, sagemaker.NotebookInstanceStatusUpdating:
+		// Instances cannot be stopped until they are in service
+		if _, err := sagemakerNotebookInstanceInServiceWaiter.wait(sagemakerNotebookInstanceStatus(conn, name), timeout); err != nil {
+			return err
+		}
+	}
+
+	if status != sagemaker.NotebookInstanceStatusStopping {
+		log.Printf("[DEBUG] Stopping SageMaker Notebook Instance: %s", name)
+		if _, err := conn.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+			NotebookInstanceName: aws.String(name),
+		}); err != nil {
+			return err
+		}
+	}
+
+	_, err = sagemakerNotebookInstanceStoppedWaiter.wait(sagemakerNotebookInstanceStatus(conn, name), timeout)
+	return err
+}
@@ -0,0 +1,162 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func resourceAwsSagemakerNotebookInstanceLifeCycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationCreate,
+		Read:   resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationRead,
+		Update: resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationUpdate,
+		Delete: resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateSagemakerName,
+			},
+
+			"on_create": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 16384),
+			},
+
+			"on_start": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 16384),
+			},
+		},
+	}
+}
+
+func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	var name string
+	if v, ok := d.GetOk("name"); ok {
+		name = v.(string)
+	} else {
+		name = resource.UniqueId()
+	}
+
+	input := &sagemaker.CreateNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(name),
+		OnCreate:                            expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_create").(string)),
+		OnStart:                             expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_start").(string)),
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Notebook Instance Lifecycle Configuration: %s", input)
+	_, err := conn.CreateNotebookInstanceLifecycleConfig(input)
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Notebook Instance Lifecycle Configuration (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	output, err := conn.DescribeNotebookInstanceLifecycleConfig(&sagemaker.DescribeNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "ValidationException", "Unable to describe Notebook Instance Lifecycle Config") {
+		log.Printf("[WARN] SageMaker Notebook Instance Lifecycle Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Notebook Instance Lifecycle Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", output.NotebookInstanceLifecycleConfigArn)
+	d.Set("name", output.NotebookInstanceLifecycleConfigName)
+	d.Set("on_create", flattenSagemakerNotebookInstanceLifecycleHooks(output.OnCreate))
+	d.Set("on_start", flattenSagemakerNotebookInstanceLifecycleHooks(output.OnStart))
+
+	return nil
+}
+
+func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	input := &sagemaker.UpdateNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(d.Id()),
+		OnCreate:                            expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_create").(string)),
+		OnStart:                             expandSagemakerNotebookInstanceLifecycleHooks(d.Get("on_start").(string)),
+	}
+
+	log.Printf("[DEBUG] Updating SageMaker Notebook Instance Lifecycle Configuration: %s", input)
+	_, err := conn.UpdateNotebookInstanceLifecycleConfig(input)
+	if err != nil {
+		return fmt.Errorf("error updating SageMaker Notebook Instance Lifecycle Configuration (%s): %s", d.Id(), err)
+	}
+
+	return resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationRead(d, meta)
+}
+
+func resourceAwsSagemakerNotebookInstanceLifeCycleConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*AWSClient).sagemakerconn
+
+	log.Printf("[DEBUG] Deleting SageMaker Notebook Instance Lifecycle Configuration: %s", d.Id())
+	_, err := conn.DeleteNotebookInstanceLifecycleConfig(&sagemaker.DeleteNotebookInstanceLifecycleConfigInput{
+		NotebookInstanceLifecycleConfigName: aws.String(d.Id()),
+	})
+
+	if isAWSErr(err, "ValidationException", "Unable to describe Notebook Instance Lifecycle Config") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Notebook Instance Lifecycle Configuration (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// The lifecycle configuration API takes a list of hooks, but only accepts a
+// single base64-encoded script for each of on_create and on_start.
+func expandSagemakerNotebookInstanceLifecycleHooks(content string) []*sagemaker.NotebookInstanceLifecycleHook {
+	if content == "" {
+		return []*sagemaker.NotebookInstanceLifecycleHook{}
+	}
+
+	return []*sagemaker.NotebookInstanceLifecycleHook{
+		{
+			Content: aws.String(content),
+		},
+	}
+}
+
+func flattenSagemakerNotebookInstanceLifecycleHooks(hooks []*sagemaker.NotebookInstanceLifecycleHook) string {
+	if len(hooks) == 0 {
+		return ""
+	}
+
+	return aws.StringValue(hooks[0].Content)
+}
@@ -555,6 +555,64 @@ func TestResourceAwsSecurityGroupIPPermGather(t *testing.T) {
 	}
 }
 
+func TestAWSSecurityGroup_offline(t *testing.T) {
+	f := newFakeAWS(t, "security_group")
+	defer f.Close()
+
+	testFakeAWSResource(t, f, "aws_security_group",
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"name":   "tf-acc-sg",
+				"vpc_id": "vpc-0a1b2c3d",
+				"ingress": []interface{}{
+					map[string]interface{}{
+						"protocol":    "tcp",
+						"from_port":   80,
+						"to_port":     8000,
+						"cidr_blocks": []interface{}{"10.0.0.0/8"},
+					},
+				},
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-sg",
+				},
+			},
+			Check: map[string]string{
+				"id":          "sg-0a1b2c3d",
+				"arn":         "arn:aws:ec2:us-west-2:123456789012:security-group/sg-0a1b2c3d",
+				"name":        "tf-acc-sg",
+				"description": "Managed by Terraform",
+				"owner_id":    "123456789012",
+				"ingress.#":   "1",
+				"egress.#":    "0",
+				"tags.%":      "1",
+				"tags.Name":   "tf-acc-sg",
+			},
+		},
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"name":   "tf-acc-sg",
+				"vpc_id": "vpc-0a1b2c3d",
+				"ingress": []interface{}{
+					map[string]interface{}{
+						"protocol":    "tcp",
+						"from_port":   80,
+						"to_port":     8080,
+						"cidr_blocks": []interface{}{"10.0.0.0/8"},
+					},
+				},
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-sg-updated",
+				},
+			},
+			Check: map[string]string{
+				"ingress.#": "1",
+				"tags.%":    "1",
+				"tags.Name": "tf-acc-sg-updated",
+			},
+		},
+	)
+}
+
 func TestAccAWSSecurityGroup_importBasic(t *testing.T) {
 	checkFn := func(s []*terraform.InstanceState) error {
 		// Expect 2: group, 2 rules
@@ -7,7 +7,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ses"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -106,13 +105,7 @@ func resourceAwsSesDomainIdentityVerificationRead(d *schema.ResourceData, meta i
 		return nil
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ses",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("identity/%s", d.Id()),
-	}.String()
+	arn := meta.(*AWSClient).regionalArn("ses", fmt.Sprintf("identity/%s", d.Id()))
 	d.Set("arn", arn)
 
 	return nil
@@ -3,12 +3,12 @@ package aws
 import (
 	"fmt"
 	"log"
-	"regexp"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/sns"
 )
@@ -121,15 +121,14 @@ func resourceAwsSnsTopicPolicyDelete(d *schema.ResourceData, meta interface{}) e
 	return err
 }
 
-func getAccountIdFromSnsTopicArn(arn, partition string) (string, error) {
+func getAccountIdFromSnsTopicArn(topicArn, partition string) (string, error) {
 	// arn:aws:sns:us-west-2:123456789012:test-new
 	// arn:aws-us-gov:sns:us-west-2:123456789012:test-new
-	re := regexp.MustCompile(fmt.Sprintf("^arn:%s:sns:[^:]+:([0-9]{12}):.+", partition))
-	matches := re.FindStringSubmatch(arn)
-	if len(matches) != 2 {
-		return "", fmt.Errorf("Unable to get account ID from ARN (%q)", arn)
+	a, err := arn.Parse(topicArn)
+	if err != nil || a.Partition != partition || a.Service != "sns" || a.AccountID == "" {
+		return "", fmt.Errorf("Unable to get account ID from ARN (%q)", topicArn)
 	}
-	return matches[1], nil
+	return a.AccountID, nil
 }
 
 func buildDefaultSnsTopicPolicy(topicArn, accountId string) string {
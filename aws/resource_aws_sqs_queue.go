@@ -365,37 +365,35 @@ func extractNameFromSqsQueueUrl(queue string) (string, error) {
 }
 
 func setTagsSQS(conn *sqs.SQS, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		create, remove := diffTagsGeneric(oraw.(map[string]interface{}), nraw.(map[string]interface{}))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			keys := make([]*string, 0, len(remove))
-			for k := range remove {
-				keys = append(keys, aws.String(k))
-			}
+	return tagsServiceSQS(conn).UpdateResource(d, d.Id())
+}
 
-			_, err := conn.UntagQueue(&sqs.UntagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				TagKeys:  keys,
+// tagsServiceSQS returns the calls that manage the tags of an SQS queue,
+// identified by its URL.
+func tagsServiceSQS(conn *sqs.SQS) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.ListQueueTags(&sqs.ListQueueTagsInput{
+				QueueUrl: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-
+			return keyValueTagsFromGeneric(resp.Tags), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
 			_, err := conn.TagQueue(&sqs.TagQueueInput{
-				QueueUrl: aws.String(d.Id()),
-				Tags:     create,
+				QueueUrl: aws.String(identifier),
+				Tags:     tags.GenericTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.UntagQueue(&sqs.UntagQueueInput{
+				QueueUrl: aws.String(identifier),
+				TagKeys:  aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
@@ -14,6 +14,46 @@ import (
 	"github.com/jen20/awspolicyequivalence"
 )
 
+func TestAWSSQSQueue_offline(t *testing.T) {
+	f := newFakeAWS(t, "sqs_queue")
+	defer f.Close()
+
+	testFakeAWSResource(t, f, "aws_sqs_queue",
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"name": "tf-acc-fake-queue",
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-queue",
+				},
+			},
+			Check: map[string]string{
+				"id":                         "https://sqs.us-west-2.amazonaws.com/123456789012/tf-acc-fake-queue",
+				"arn":                        "arn:aws:sqs:us-west-2:123456789012:tf-acc-fake-queue",
+				"name":                       "tf-acc-fake-queue",
+				"visibility_timeout_seconds": "30",
+				"message_retention_seconds":  "345600",
+				"fifo_queue":                 "false",
+				"tags.%":                     "1",
+				"tags.Name":                  "tf-acc-queue",
+			},
+		},
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"name":                       "tf-acc-fake-queue",
+				"visibility_timeout_seconds": 60,
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-queue-updated",
+				},
+			},
+			Check: map[string]string{
+				"visibility_timeout_seconds": "60",
+				"tags.%":                     "1",
+				"tags.Name":                  "tf-acc-queue-updated",
+			},
+		},
+	)
+}
+
 func TestAccAWSSQSQueue_importBasic(t *testing.T) {
 	resourceName := "aws_sqs_queue.queue"
 	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(5))
@@ -38,6 +78,42 @@ func TestAccAWSSQSQueue_importBasic(t *testing.T) {
 	})
 }
 
+func TestAccAWSSQSQueue_region(t *testing.T) {
+	var queueAttributes map[string]*string
+
+	resourceName := "aws_sqs_queue.queue"
+	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(5))
+	region := testAccGetAlternateRegion()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAWSSQSQueueDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSQSConfigWithRegion(queueName, region),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAWSSQSQueueExists(resourceName, &queueAttributes),
+					resource.TestCheckResourceAttr(resourceName, "region", region),
+					resource.TestMatchResourceAttr(resourceName, "arn", regexp.MustCompile(":sqs:"+region+":")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("Not found: %s", resourceName)
+					}
+					return rs.Primary.ID + "@" + region, nil
+				},
+			},
+		},
+	})
+}
+
 func TestAccAWSSQSQueue_importFifo(t *testing.T) {
 	resourceName := "aws_sqs_queue.queue"
 	queueName := fmt.Sprintf("sqs-queue-%s", acctest.RandString(5))
@@ -383,18 +459,22 @@ func TestAccAWSSQSQueue_Encryption(t *testing.T) {
 }
 
 func testAccCheckAWSSQSQueueDestroy(s *terraform.State) error {
-	conn := testAccProvider.Meta().(*AWSClient).sqsconn
-
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "aws_sqs_queue" {
 			continue
 		}
 
+		client, err := testAccProvider.Meta().(*AWSClient).withRegion(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+		conn := client.sqsconn
+
 		// Check if queue exists by checking for its attributes
 		params := &sqs.GetQueueAttributesInput{
 			QueueUrl: aws.String(rs.Primary.ID),
 		}
-		err := resource.Retry(15*time.Second, func() *resource.RetryError {
+		err = resource.Retry(15*time.Second, func() *resource.RetryError {
 			_, err := conn.GetQueueAttributes(params)
 			if err != nil {
 				if isAWSErr(err, sqs.ErrCodeQueueDoesNotExist, "") {
@@ -445,7 +525,11 @@ func testAccCheckAWSSQSQueueExists(resourceName string, queueAttributes *map[str
 			return fmt.Errorf("No Queue URL specified!")
 		}
 
-		conn := testAccProvider.Meta().(*AWSClient).sqsconn
+		client, err := testAccProvider.Meta().(*AWSClient).withRegion(rs.Primary.Attributes["region"])
+		if err != nil {
+			return err
+		}
+		conn := client.sqsconn
 
 		input := &sqs.GetQueueAttributesInput{
 			QueueUrl:       aws.String(rs.Primary.ID),
@@ -531,6 +615,15 @@ resource "aws_sqs_queue" "queue" {
 `, r)
 }
 
+func testAccAWSSQSConfigWithRegion(r, region string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "queue" {
+  name   = "%s"
+  region = "%s"
+}
+`, r, region)
+}
+
 func testAccAWSSQSConfigWithNamePrefix(r string) string {
 	return fmt.Sprintf(`
 resource "aws_sqs_queue" "queue" {
@@ -3,6 +3,7 @@ package aws
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -15,6 +16,9 @@ func resourceAwsSsmAssociation() *schema.Resource {
 		Read:   resourceAwsSsmAssociationRead,
 		Update: resourceAwsSsmAssociationUpdate,
 		Delete: resourceAwsSsmAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		MigrateState:  resourceAwsSsmAssociationMigrateState,
 		SchemaVersion: 1,
@@ -170,7 +174,9 @@ func resourceAwsSsmAssociationRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("association_name", association.AssociationName)
 	d.Set("instance_id", association.InstanceId)
 	d.Set("name", association.Name)
-	d.Set("parameters", association.Parameters)
+	if err := d.Set("parameters", flattenSSMDocumentParameters(association.Parameters)); err != nil {
+		return fmt.Errorf("Error setting parameters error: %#v", err)
+	}
 	d.Set("association_id", association.AssociationId)
 	d.Set("schedule_expression", association.ScheduleExpression)
 	d.Set("document_version", association.DocumentVersion)
@@ -257,6 +263,17 @@ func expandSSMDocumentParameters(params map[string]interface{}) map[string][]*st
 	return docParams
 }
 
+// flattenSSMDocumentParameters returns the values of each parameter joined by
+// commas, as parameters are configured as a map of strings.
+func flattenSSMDocumentParameters(params map[string][]*string) map[string]string {
+	result := make(map[string]string, len(params))
+	for k, v := range params {
+		result[k] = strings.Join(aws.StringValueSlice(v), ",")
+	}
+
+	return result
+}
+
 func expandSSMAssociationOutputLocation(config []interface{}) *ssm.InstanceAssociationOutputLocation {
 	if config == nil {
 		return nil
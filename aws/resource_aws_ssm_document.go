@@ -8,7 +8,6 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/hashicorp/terraform/helper/resource"
@@ -26,6 +25,9 @@ func resourceAwsSsmDocument() *schema.Resource {
 		Read:   resourceAwsSsmDocumentRead,
 		Update: resourceAwsSsmDocumentUpdate,
 		Delete: resourceAwsSsmDocumentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -195,7 +197,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[DEBUG] Reading SSM Document: %s", d.Id())
 
 	docInput := &ssm.DescribeDocumentInput{
-		Name: aws.String(d.Get("name").(string)),
+		Name: aws.String(d.Id()),
 	}
 
 	resp, err := ssmconn.DescribeDocument(docInput)
@@ -214,10 +216,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("description", doc.Description)
 	d.Set("schema_version", doc.SchemaVersion)
 
-	if _, ok := d.GetOk("document_type"); ok {
-		d.Set("document_type", doc.DocumentType)
-	}
-
+	d.Set("document_type", doc.DocumentType)
 	d.Set("document_format", doc.DocumentFormat)
 	d.Set("document_version", doc.DocumentVersion)
 	d.Set("hash", doc.Hash)
@@ -226,19 +225,25 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", doc.Name)
 	d.Set("owner", doc.Owner)
 	d.Set("platform_types", flattenStringList(doc.PlatformTypes))
-	arn := arn.ARN{
-		Partition: meta.(*AWSClient).partition,
-		Service:   "ssm",
-		Region:    meta.(*AWSClient).region,
-		AccountID: meta.(*AWSClient).accountid,
-		Resource:  fmt.Sprintf("document/%s", *doc.Name),
-	}.String()
+	arn := meta.(*AWSClient).regionalArn("ssm", fmt.Sprintf("document/%s", *doc.Name))
 	if err := d.Set("arn", arn); err != nil {
 		return fmt.Errorf("Error setting arn error: %#v", err)
 	}
 
 	d.Set("status", doc.Status)
 
+	// The content is only returned by GetDocument, in the format it was
+	// written in.
+	getDocOutput, err := ssmconn.GetDocument(&ssm.GetDocumentInput{
+		Name:            doc.Name,
+		DocumentFormat:  doc.DocumentFormat,
+		DocumentVersion: doc.DefaultVersion,
+	})
+	if err != nil {
+		return fmt.Errorf("Error getting SSM document content: %s", err)
+	}
+	d.Set("content", getDocOutput.Content)
+
 	gp, err := getDocumentPermissions(d, meta)
 
 	if err != nil {
@@ -291,7 +296,7 @@ func resourceAwsSsmDocumentRead(d *schema.ResourceData, meta interface{}) error
 func resourceAwsSsmDocumentUpdate(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := setTagsSSM(ssmconn, d, d.Id(), ssm.ResourceTypeForTaggingDocument); err != nil {
 			return fmt.Errorf("error setting SSM Document tags: %s", err)
 		}
@@ -439,7 +444,7 @@ func getDocumentPermissions(d *schema.ResourceData, meta interface{}) (map[strin
 	permissionType := "Share"
 
 	permInput := &ssm.DescribeDocumentPermissionInput{
-		Name:           aws.String(d.Get("name").(string)),
+		Name:           aws.String(d.Id()),
 		PermissionType: aws.String(permissionType),
 	}
 
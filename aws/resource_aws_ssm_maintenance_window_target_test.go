@@ -32,6 +32,12 @@ func TestAccAWSSSMMaintenanceWindowTarget_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("aws_ssm_maintenance_window_target.target", "targets.1.values.1", "acceptance_test2"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window_target.target",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc("aws_ssm_maintenance_window_target.target"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -193,3 +199,14 @@ resource "aws_ssm_maintenance_window_target" "target" {
 }
 `, rName)
 }
+
+func testAccAWSSSMMaintenanceWindowTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["window_id"], rs.Primary.ID), nil
+	}
+}
@@ -3,6 +3,8 @@ package aws
 import (
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -14,6 +16,17 @@ func resourceAwsSsmMaintenanceWindowTask() *schema.Resource {
 		Create: resourceAwsSsmMaintenanceWindowTaskCreate,
 		Read:   resourceAwsSsmMaintenanceWindowTaskRead,
 		Delete: resourceAwsSsmMaintenanceWindowTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idParts := strings.Split(d.Id(), "/")
+				if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected WINDOW-ID/WINDOW-TASK-ID", d.Id())
+				}
+				d.Set("window_id", idParts[0])
+				d.SetId(idParts[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"window_id": {
@@ -166,12 +179,20 @@ func expandAwsSsmTaskParameters(config []interface{}) map[string]*ssm.Maintenanc
 	return params
 }
 
+// flattenAwsSsmTaskParameters returns the task parameters sorted by name, so
+// that they are read back in a stable order.
 func flattenAwsSsmTaskParameters(taskParameters map[string]*ssm.MaintenanceWindowTaskParameterValueExpression) []interface{} {
+	names := make([]string, 0, len(taskParameters))
+	for k := range taskParameters {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
 	result := make([]interface{}, 0, len(taskParameters))
-	for k, v := range taskParameters {
+	for _, k := range names {
 		taskParam := map[string]interface{}{
 			"name":   k,
-			"values": flattenStringList(v.Values),
+			"values": flattenStringList(taskParameters[k].Values),
 		}
 		result = append(result, taskParam)
 	}
@@ -36,6 +36,11 @@ func TestAccAWSSSMMaintenanceWindow_basic(t *testing.T) {
 						"aws_ssm_maintenance_window.foo", "enabled", "false"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_maintenance_window.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAWSSSMMaintenanceWindowBasicConfigUpdated(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -1,7 +1,9 @@
 package aws
 
 import (
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -13,6 +15,20 @@ func resourceAwsSsmPatchGroup() *schema.Resource {
 		Create: resourceAwsSsmPatchGroupCreate,
 		Read:   resourceAwsSsmPatchGroupRead,
 		Delete: resourceAwsSsmPatchGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// Patch group names may contain slashes, so the baseline ID
+				// follows the last comma.
+				i := strings.LastIndex(d.Id(), ",")
+				if i <= 0 || i == len(d.Id())-1 {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected PATCH-GROUP,BASELINE-ID", d.Id())
+				}
+				d.Set("patch_group", d.Id()[:i])
+				d.Set("baseline_id", d.Id()[i+1:])
+				d.SetId(d.Id()[:i])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"baseline_id": {
@@ -49,21 +65,37 @@ func resourceAwsSsmPatchGroupCreate(d *schema.ResourceData, meta interface{}) er
 func resourceAwsSsmPatchGroupRead(d *schema.ResourceData, meta interface{}) error {
 	ssmconn := meta.(*AWSClient).ssmconn
 
-	params := &ssm.DescribePatchGroupsInput{}
+	// A patch group can be registered with a baseline for each operating
+	// system, so the baseline is matched as well once it is known.
+	baselineId := d.Get("baseline_id").(string)
 
-	resp, err := ssmconn.DescribePatchGroups(params)
-	if err != nil {
-		return err
-	}
+	params := &ssm.DescribePatchGroupsInput{}
 
 	found := false
-	for _, t := range resp.Mappings {
-		if *t.PatchGroup == d.Id() {
+	for {
+		resp, err := ssmconn.DescribePatchGroups(params)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range resp.Mappings {
+			if aws.StringValue(t.PatchGroup) != d.Id() {
+				continue
+			}
+			if baselineId != "" && aws.StringValue(t.BaselineIdentity.BaselineId) != baselineId {
+				continue
+			}
 			found = true
 
 			d.Set("patch_group", t.PatchGroup)
 			d.Set("baseline_id", t.BaselineIdentity.BaselineId)
+			break
+		}
+
+		if found || resp.NextToken == nil {
+			break
 		}
+		params.NextToken = resp.NextToken
 	}
 
 	if !found {
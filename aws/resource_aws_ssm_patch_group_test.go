@@ -24,6 +24,12 @@ func TestAccAWSSSMPatchGroup_basic(t *testing.T) {
 					testAccCheckAWSSSMPatchGroupExists("aws_ssm_patch_group.patchgroup"),
 				),
 			},
+			{
+				ResourceName:      "aws_ssm_patch_group.patchgroup",
+				ImportState:       true,
+				ImportStateIdFunc: testAccAWSSSMPatchGroupImportStateIdFunc("aws_ssm_patch_group.patchgroup"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -101,3 +107,14 @@ resource "aws_ssm_patch_group" "patchgroup" {
 
 `, rName)
 }
+
+func testAccAWSSSMPatchGroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["patch_group"], rs.Primary.Attributes["baseline_id"]), nil
+	}
+}
@@ -87,6 +87,50 @@ func testSweepSubnets(region string) error {
 	return nil
 }
 
+func TestAWSSubnet_offline(t *testing.T) {
+	f := newFakeAWS(t, "subnet")
+	defer f.Close()
+
+	testFakeAWSResource(t, f, "aws_subnet",
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"vpc_id":            "vpc-0a1b2c3d",
+				"cidr_block":        "10.1.1.0/24",
+				"availability_zone": "us-west-2a",
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-subnet",
+				},
+			},
+			Check: map[string]string{
+				"id":                      "subnet-0a1b2c3d",
+				"arn":                     "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-0a1b2c3d",
+				"vpc_id":                  "vpc-0a1b2c3d",
+				"cidr_block":              "10.1.1.0/24",
+				"availability_zone":       "us-west-2a",
+				"map_public_ip_on_launch": "false",
+				"tags.%":                  "1",
+				"tags.Name":               "tf-acc-subnet",
+			},
+		},
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"vpc_id":                  "vpc-0a1b2c3d",
+				"cidr_block":              "10.1.1.0/24",
+				"availability_zone":       "us-west-2a",
+				"map_public_ip_on_launch": true,
+				"tags": map[string]interface{}{
+					"Name": "tf-acc-subnet-updated",
+				},
+			},
+			Check: map[string]string{
+				"map_public_ip_on_launch": "true",
+				"tags.%":                  "1",
+				"tags.Name":               "tf-acc-subnet-updated",
+			},
+		},
+	)
+}
+
 func TestAccAWSSubnet_importBasic(t *testing.T) {
 	resourceName := "aws_subnet.foo"
 
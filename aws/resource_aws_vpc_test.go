@@ -88,6 +88,48 @@ func testSweepVPCs(region string) error {
 	return nil
 }
 
+func TestAWSVpc_offline(t *testing.T) {
+	f := newFakeAWS(t, "vpc")
+	defer f.Close()
+
+	testFakeAWSResource(t, f, "aws_vpc",
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"cidr_block": "10.1.0.0/16",
+				"tags": map[string]interface{}{
+					"Name": "terraform-testacc-vpc",
+				},
+			},
+			Check: map[string]string{
+				"id":                     "vpc-0a1b2c3d",
+				"arn":                    "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0a1b2c3d",
+				"cidr_block":             "10.1.0.0/16",
+				"instance_tenancy":       "default",
+				"enable_dns_support":     "true",
+				"enable_dns_hostnames":   "false",
+				"default_route_table_id": "rtb-0a1b2c3d",
+				"tags.%":                 "1",
+				"tags.Name":              "terraform-testacc-vpc",
+				"region":                 "us-west-2",
+			},
+		},
+		fakeAWSResourceStep{
+			Config: map[string]interface{}{
+				"cidr_block":           "10.1.0.0/16",
+				"enable_dns_hostnames": true,
+				"tags": map[string]interface{}{
+					"Name": "terraform-testacc-vpc-updated",
+				},
+			},
+			Check: map[string]string{
+				"enable_dns_hostnames": "true",
+				"tags.%":               "1",
+				"tags.Name":            "terraform-testacc-vpc-updated",
+			},
+		},
+	)
+}
+
 func TestAccAWSVpc_importBasic(t *testing.T) {
 	resourceName := "aws_vpc.foo"
 
@@ -281,6 +323,66 @@ func TestAccAWSVpc_tags(t *testing.T) {
 	})
 }
 
+func TestAccAWSVpc_defaultTags(t *testing.T) {
+	var vpc ec2.Vpc
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigDefaultTags("Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					testAccCheckTags(&vpc.Tags, "Environment", "Production"),
+					testAccCheckTags(&vpc.Tags, "Owner", "Network"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags.Owner", "Network"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.%", "3"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.Environment", "Production"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.Owner", "Network"),
+				),
+			},
+
+			{
+				Config: testAccVpcConfigDefaultTags("Staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					testAccCheckTags(&vpc.Tags, "Environment", "Staging"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags.%", "2"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.%", "3"),
+					resource.TestCheckResourceAttr("aws_vpc.foo", "tags_all.Environment", "Staging"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAWSVpc_ignoreTags(t *testing.T) {
+	var vpc ec2.Vpc
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVpcDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVpcConfigIgnoreTags,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVpcExists("aws_vpc.foo", &vpc),
+					testAccCheckVpcAddTag(&vpc, "CostCenter", "1234"),
+					testAccCheckVpcAddTag(&vpc, "kubernetes.io/cluster/example", "owned"),
+				),
+			},
+			{
+				Config:   testAccVpcConfigIgnoreTags,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAWSVpc_update(t *testing.T) {
 	var vpc ec2.Vpc
 
@@ -375,6 +477,22 @@ func testAccCheckVpcIdsNotEqual(vpc1, vpc2 *ec2.Vpc) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckVpcAddTag(vpc *ec2.Vpc, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := testAccProvider.Meta().(*AWSClient).ec2conn
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: []*string{vpc.VpcId},
+			Tags: []*ec2.Tag{
+				{
+					Key:   aws.String(key),
+					Value: aws.String(value),
+				},
+			},
+		})
+		return err
+	}
+}
+
 func testAccCheckVpcExists(n string, vpc *ec2.Vpc) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -535,6 +653,46 @@ resource "aws_vpc" "foo" {
 	}
 }
 `
+
+func testAccVpcConfigDefaultTags(environment string) string {
+	return fmt.Sprintf(`
+provider "aws" {
+	default_tags {
+		tags {
+			Environment = %q
+			Owner = "Ops"
+		}
+	}
+}
+
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+
+	tags {
+		Owner = "Network"
+		Name = "terraform-testacc-vpc-default-tags"
+	}
+}
+`, environment)
+}
+
+const testAccVpcConfigIgnoreTags = `
+provider "aws" {
+	ignore_tags {
+		keys = ["CostCenter"]
+		key_prefixes = ["kubernetes.io/cluster/"]
+	}
+}
+
+resource "aws_vpc" "foo" {
+	cidr_block = "10.1.0.0/16"
+
+	tags {
+		Name = "terraform-testacc-vpc-ignore-tags"
+	}
+}
+`
+
 const testAccVpcDedicatedConfig = `
 resource "aws_vpc" "foo" {
 	instance_tenancy = "dedicated"
@@ -17,6 +17,15 @@ func resourceAwsWafRegionalWebAclAssociation() *schema.Resource {
 		Create: resourceAwsWafRegionalWebAclAssociationCreate,
 		Read:   resourceAwsWafRegionalWebAclAssociationRead,
 		Delete: resourceAwsWafRegionalWebAclAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				webAclId, resourceArn := resourceAwsWafRegionalWebAclAssociationParseId(d.Id())
+				if webAclId == "" || resourceArn == "" {
+					return nil, fmt.Errorf("Unexpected format of ID (%q), expected WEB-ACL-ID:RESOURCE-ARN", d.Id())
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"web_acl_id": {
@@ -95,8 +104,12 @@ func resourceAwsWafRegionalWebAclAssociationRead(d *schema.ResourceData, meta in
 	if !found {
 		log.Printf("[WARN] WAF Regional Web ACL association (%s) not found, removing from state", d.Id())
 		d.SetId("")
+		return nil
 	}
 
+	d.Set("web_acl_id", webAclId)
+	d.Set("resource_arn", resourceArn)
+
 	return nil
 }
 
@@ -123,6 +136,8 @@ func resourceAwsWafRegionalWebAclAssociationDelete(d *schema.ResourceData, meta
 func resourceAwsWafRegionalWebAclAssociationParseId(id string) (webAclId, resourceArn string) {
 	parts := strings.SplitN(id, ":", 2)
 	webAclId = parts[0]
-	resourceArn = parts[1]
+	if len(parts) > 1 {
+		resourceArn = parts[1]
+	}
 	return
 }
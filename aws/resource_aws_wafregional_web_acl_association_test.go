@@ -24,6 +24,11 @@ func TestAccAWSWafRegionalWebAclAssociation_basic(t *testing.T) {
 					testAccCheckWafRegionalWebAclAssociationExists("aws_wafregional_web_acl_association.foo"),
 				),
 			},
+			{
+				ResourceName:      "aws_wafregional_web_acl_association.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -12,38 +11,37 @@ import (
 
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
-func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsS3(tagsFromMapS3(o), tagsFromMapS3(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %#v", remove)
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
-					Bucket: aws.String(d.Get("bucket").(string)),
-				})
-			})
+func setTagsS3(conn *s3.S3, d *schema.ResourceData, ignoreTags *ignoreTagsConfig) error {
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
+		o := newKeyValueTags(oraw.(map[string]interface{})).IgnoreAws()
+		n := newKeyValueTags(nraw.(map[string]interface{})).IgnoreAws()
+		bucket := d.Get("bucket").(string)
+
+		// PutBucketTagging replaces the whole tag set, so carry over the
+		// tags that the provider is configured to ignore
+		if ignoreTags != nil {
+			ignored, err := getIgnoredTagSetS3(conn, bucket, ignoreTags)
 			if err != nil {
 				return err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %#v", create)
-			req := &s3.PutBucketTaggingInput{
-				Bucket: aws.String(d.Get("bucket").(string)),
-				Tagging: &s3.Tagging{
-					TagSet: create,
-				},
+			for _, t := range ignored {
+				if _, ok := n[aws.StringValue(t.Key)]; !ok {
+					n[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+				}
 			}
+		}
 
-			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
-				return conn.PutBucketTagging(req)
-			})
-			if err != nil {
+		service := tagsServiceS3(conn)
+		if len(o.Removed(n)) > 0 {
+			log.Printf("[DEBUG] Removing tags from %s", bucket)
+			if err := service.Untag(bucket, o); err != nil {
+				return err
+			}
+		}
+		if len(n) > 0 {
+			log.Printf("[DEBUG] Creating tags on %s: %s", bucket, n.Keys())
+			if err := service.Tag(bucket, n); err != nil {
 				return err
 			}
 		}
@@ -52,55 +50,56 @@ func setTagsS3(conn *s3.S3, d *schema.ResourceData) error {
 	return nil
 }
 
+// tagsServiceS3 returns the calls that manage the tags of an S3 bucket. S3
+// only supports replacing or deleting the whole tag set of a bucket, so Tag
+// must be given every tag the bucket is to have and Untag removes them all.
+func tagsServiceS3(conn *s3.S3) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			ts, err := getTagSetS3(conn, identifier)
+			if err != nil {
+				return nil, err
+			}
+			return keyValueTagsFromS3(ts), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+				return conn.PutBucketTagging(&s3.PutBucketTaggingInput{
+					Bucket: aws.String(identifier),
+					Tagging: &s3.Tagging{
+						TagSet: tags.S3Tags(),
+					},
+				})
+			})
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := retryOnAwsCodes([]string{"NoSuchBucket", "OperationAborted"}, func() (interface{}, error) {
+				return conn.DeleteBucketTagging(&s3.DeleteBucketTaggingInput{
+					Bucket: aws.String(identifier),
+				})
+			})
+			return err
+		},
+	}
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsS3(oldTags, newTags []*s3.Tag) ([]*s3.Tag, []*s3.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*s3.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapS3(create), remove
+	o, n := keyValueTagsFromS3(oldTags), keyValueTagsFromS3(newTags)
+	return o.Updated(n).S3Tags(), o.Removed(n).S3Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapS3(m map[string]interface{}) []*s3.Tag {
-	result := make([]*s3.Tag, 0, len(m))
-	for k, v := range m {
-		t := &s3.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredS3(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().S3Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapS3(ts []*s3.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredS3(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyValueTagsFromS3(ts).IgnoreAws().Map()
 }
 
 // return a slice of s3 tags associated with the given s3 bucket. Essentially
@@ -122,16 +121,48 @@ func getTagSetS3(s3conn *s3.S3, bucket string) ([]*s3.Tag, error) {
 	return response.TagSet, nil
 }
 
+// return the tags of the given s3 bucket that are excluded by the provider
+// ignore_tags and not otherwise ignored.
+func getIgnoredTagSetS3(s3conn *s3.S3, bucket string, ignoreTags *ignoreTagsConfig) ([]*s3.Tag, error) {
+	tagSet, err := getTagSetS3(s3conn, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*s3.Tag
+	for _, t := range tagSet {
+		if ignoreTags.ignored(*t.Key) && !tagIgnoredS3(t) {
+			result = append(result, t)
+		}
+	}
+
+	return result, nil
+}
+
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredS3(t *s3.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+	return tagKeyIgnoredAws(aws.StringValue(t.Key))
+}
+
+func keyValueTagsFromS3(ts []*s3.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	return false
+
+	return tags
+}
+
+// S3Tags returns the tags as S3 tags.
+func (tags keyValueTags) S3Tags() []*s3.Tag {
+	result := make([]*s3.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &s3.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
 }
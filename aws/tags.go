@@ -2,7 +2,6 @@ package aws
 
 import (
 	"log"
-	"regexp"
 	"strings"
 	"time"
 
@@ -33,91 +32,65 @@ func tagsSchemaComputed() *schema.Schema {
 }
 
 func setElbV2Tags(conn *elbv2.ELBV2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffElbV2Tags(tagsFromMapELBv2(o), tagsFromMapELBv2(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			var tagKeys []*string
-			for _, tag := range remove {
-				tagKeys = append(tagKeys, tag.Key)
-			}
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				TagKeys:      tagKeys,
+	return tagsServiceELBv2(conn).UpdateResource(d, d.Id())
+}
+
+// tagsServiceELBv2 returns the calls that manage the tags of an application or
+// network load balancer or target group.
+func tagsServiceELBv2(conn *elbv2.ELBV2) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.DescribeTags(&elbv2.DescribeTagsInput{
+				ResourceArns: []*string{aws.String(identifier)},
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
+			var ts []*elbv2.Tag
+			if len(resp.TagDescriptions) > 0 {
+				ts = resp.TagDescriptions[0].Tags
+			}
+			return keyValueTagsFromELBv2(ts), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
 			_, err := conn.AddTags(&elbv2.AddTagsInput{
-				ResourceArns: []*string{aws.String(d.Id())},
-				Tags:         create,
+				ResourceArns: []*string{aws.String(identifier)},
+				Tags:         tags.ELBv2Tags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.RemoveTags(&elbv2.RemoveTagsInput{
+				ResourceArns: []*string{aws.String(identifier)},
+				TagKeys:      aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 	if d.HasChange("volume_tags") {
 		oraw, nraw := d.GetChange("volume_tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+		oldTags := newKeyValueTags(oraw.(map[string]interface{})).IgnoreAws()
+		newTags := newKeyValueTags(nraw.(map[string]interface{})).IgnoreAws()
 
 		volumeIds, err := getAwsInstanceVolumeIds(conn, d)
 		if err != nil {
 			return err
 		}
 
-		if len(remove) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing volume tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: volumeIds,
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
+		// All the volumes of the instance are tagged by a single call.
+		if removed := oldTags.Removed(newTags); len(removed) > 0 {
+			log.Printf("[DEBUG] Removing volume tags from %s: %s", d.Id(), removed.Keys())
+			if err := deleteTagsEC2(conn, volumeIds, removed, 2*time.Minute); err != nil {
 				return err
 			}
 		}
-		if len(create) > 0 {
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating vol tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: volumeIds,
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
+
+		if updated := oldTags.Updated(newTags); len(updated) > 0 {
+			log.Printf("[DEBUG] Creating volume tags on %s: %s", d.Id(), updated.Keys())
+			if err := createTagsEC2(conn, volumeIds, updated, 2*time.Minute); err != nil {
 				return err
 			}
 		}
@@ -129,203 +102,131 @@ func setVolumeTags(conn *ec2.EC2, d *schema.ResourceData) error {
 // setTags is a helper to set the tags for a resource. It expects the
 // tags field to be named "tags"
 func setTags(conn *ec2.EC2, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
-
-		// Set tags
-		if len(remove) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-				_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      remove,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
+	return tagsServiceEC2(conn, 5*time.Minute).UpdateResource(d, d.Id())
+}
+
+// tagsServiceEC2 returns the calls that manage the tags of an EC2 resource.
+// Tagging is retried for up to timeout while the resource is not yet visible
+// to the EC2 API.
+func tagsServiceEC2(conn *ec2.EC2, timeout time.Duration) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.DescribeTags(&ec2.DescribeTagsInput{
+				Filters: []*ec2.Filter{
+					{
+						Name:   aws.String("resource-id"),
+						Values: []*string{aws.String(identifier)},
+					},
+				},
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-				log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-				_, err := conn.CreateTags(&ec2.CreateTagsInput{
-					Resources: []*string{aws.String(d.Id())},
-					Tags:      create,
-				})
-				if err != nil {
-					ec2err, ok := err.(awserr.Error)
-					if ok && strings.Contains(ec2err.Code(), ".NotFound") {
-						return resource.RetryableError(err) // retry
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return err
+			tags := make(keyValueTags, len(resp.Tags))
+			for _, t := range resp.Tags {
+				tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 			}
-		}
+			return tags, nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			return createTagsEC2(conn, []*string{aws.String(identifier)}, tags, timeout)
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			return deleteTagsEC2(conn, []*string{aws.String(identifier)}, tags, timeout)
+		},
 	}
+}
 
-	return nil
+// createTagsEC2 adds tags to EC2 resources, retrying for up to timeout while
+// any of them is not yet visible to the EC2 API.
+func createTagsEC2(conn *ec2.EC2, ids []*string, tags keyValueTags, timeout time.Duration) error {
+	return retryEC2NotFound(timeout, func() error {
+		_, err := conn.CreateTags(&ec2.CreateTagsInput{
+			Resources: ids,
+			Tags:      tags.EC2Tags(),
+		})
+		return err
+	})
+}
+
+// deleteTagsEC2 removes tags from EC2 resources, retrying for up to timeout
+// while any of them is not yet visible to the EC2 API.
+func deleteTagsEC2(conn *ec2.EC2, ids []*string, tags keyValueTags, timeout time.Duration) error {
+	return retryEC2NotFound(timeout, func() error {
+		_, err := conn.DeleteTags(&ec2.DeleteTagsInput{
+			Resources: ids,
+			Tags:      tags.EC2Tags(),
+		})
+		return err
+	})
+}
+
+func retryEC2NotFound(timeout time.Duration, f func() error) error {
+	return resource.Retry(timeout, func() *resource.RetryError {
+		err := f()
+		if err != nil {
+			ec2err, ok := err.(awserr.Error)
+			if ok && strings.Contains(ec2err.Code(), ".NotFound") {
+				return resource.RetryableError(err) // retry
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTags(oldTags, newTags []*ec2.Tag) ([]*ec2.Tag, []*ec2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*ec2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMap(create), remove
+	o, n := keyValueTagsFromEC2(oldTags), keyValueTagsFromEC2(newTags)
+	return o.Updated(n).EC2Tags(), o.Removed(n).EC2Tags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMap(m map[string]interface{}) []*ec2.Tag {
-	result := make([]*ec2.Tag, 0, len(m))
-	for k, v := range m {
-		t := &ec2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnored(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().EC2Tags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMap(ts []*ec2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnored(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyValueTagsFromEC2(ts).IgnoreAws().Map()
 }
 
 func diffElbV2Tags(oldTags, newTags []*elbv2.Tag) ([]*elbv2.Tag, []*elbv2.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*elbv2.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapELBv2(create), remove
+	o, n := keyValueTagsFromELBv2(oldTags), keyValueTagsFromELBv2(newTags)
+	return o.Updated(n).ELBv2Tags(), o.Removed(n).ELBv2Tags()
 }
 
 // tagsToMapELBv2 turns the list of tags into a map.
 func tagsToMapELBv2(ts []*elbv2.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredELBv2(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyValueTagsFromELBv2(ts).IgnoreAws().Map()
 }
 
 // tagsFromMapELBv2 returns the tags for the given map of data.
 func tagsFromMapELBv2(m map[string]interface{}) []*elbv2.Tag {
-	var result []*elbv2.Tag
-	for k, v := range m {
-		t := &elbv2.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredELBv2(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().ELBv2Tags()
 }
 
 // tagIgnored compares a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnored(t *ec2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnoredAws(aws.StringValue(t.Key))
 }
 
 // and for ELBv2 as well
 func tagIgnoredELBv2(t *elbv2.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
-	}
-	return false
+	return tagKeyIgnoredAws(aws.StringValue(t.Key))
 }
 
 // tagsToMapDynamoDb turns the list of tags into a map for dynamoDB
 func tagsToMapDynamoDb(ts []*dynamodb.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-	return result
+	return keyValueTagsFromDynamoDb(ts).IgnoreAws().Map()
 }
 
 // tagsFromMapDynamoDb returns the tags for a given map
 func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
-	result := make([]*dynamodb.Tag, 0, len(m))
-	for k, v := range m {
-		t := &dynamodb.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		result = append(result, t)
-	}
-	return result
+	return newKeyValueTags(m).IgnoreAws().DynamoDbTags()
 }
 
 // setTagsDynamoDb is a helper to set the tags for a dynamoDB resource
@@ -333,20 +234,15 @@ func tagsFromMapDynamoDb(m map[string]interface{}) []*dynamodb.Tag {
 // method from the ec2 tag resource handling. Also the `UntagResource` method
 // for dynamoDB only requires a list of tag keys, instead of the full map of keys.
 func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
-	arn := d.Get("arn").(string)
-	oraw, nraw := d.GetChange("tags")
-	o := oraw.(map[string]interface{})
-	n := nraw.(map[string]interface{})
-	create, remove := diffTagsDynamoDb(tagsFromMapDynamoDb(o), tagsFromMapDynamoDb(n))
-
-	// Set tags
-	if len(remove) > 0 {
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			log.Printf("[DEBUG] Removing tags: %#v from %s", remove, d.Id())
-			_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     remove,
-			})
+	return tagsServiceDynamoDb(conn).UpdateResource(d, d.Get("arn").(string))
+}
+
+// tagsServiceDynamoDb returns the calls that manage the tags of a DynamoDB
+// table. Tagging is retried while the table is not yet found.
+func tagsServiceDynamoDb(conn *dynamodb.DynamoDB) keyValueTagsService {
+	retryNotFound := func(f func() error) error {
+		return resource.Retry(2*time.Minute, func() *resource.RetryError {
+			err := f()
 			if err != nil {
 				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
 					return resource.RetryableError(err)
@@ -355,49 +251,101 @@ func setTagsDynamoDb(conn *dynamodb.DynamoDB, d *schema.ResourceData) error {
 			}
 			return nil
 		})
-		if err != nil {
-			return err
-		}
 	}
-	if len(create) > 0 {
-		err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-			log.Printf("[DEBUG] Creating tags: %s for %s", create, d.Id())
-			_, err := conn.TagResource(&dynamodb.TagResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        create,
+
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.ListTagsOfResource(&dynamodb.ListTagsOfResourceInput{
+				ResourceArn: aws.String(identifier),
 			})
 			if err != nil {
-				if isAWSErr(err, dynamodb.ErrCodeResourceNotFoundException, "") {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
+				return nil, err
 			}
-			return nil
+			return keyValueTagsFromDynamoDb(resp.Tags), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			return retryNotFound(func() error {
+				_, err := conn.TagResource(&dynamodb.TagResourceInput{
+					ResourceArn: aws.String(identifier),
+					Tags:        tags.DynamoDbTags(),
+				})
+				return err
+			})
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			return retryNotFound(func() error {
+				_, err := conn.UntagResource(&dynamodb.UntagResourceInput{
+					ResourceArn: aws.String(identifier),
+					TagKeys:     aws.StringSlice(tags.Keys()),
+				})
+				return err
+			})
+		},
+	}
+}
+
+func keyValueTagsFromEC2(ts []*ec2.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// EC2Tags returns the tags as EC2 tags.
+func (tags keyValueTags) EC2Tags() []*ec2.Tag {
+	result := make([]*ec2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &ec2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
 		})
-		if err != nil {
-			return err
-		}
 	}
 
-	return nil
+	return result
 }
 
-// diffTagsDynamoDb takes a local set of dynamodb tags and the ones found remotely
-// and returns the set of tags that must be created as a map, and returns a list of tag keys
-// that must be destroyed.
-func diffTagsDynamoDb(oldTags, newTags []*dynamodb.Tag) ([]*dynamodb.Tag, []*string) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
+func keyValueTagsFromELBv2(ts []*elbv2.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	var remove []*string
-	for _, t := range oldTags {
-		// Verify the old tag is not a tag we're currently attempting to create
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			remove = append(remove, t.Key)
-		}
+	return tags
+}
+
+// ELBv2Tags returns the tags as ELBv2 tags.
+func (tags keyValueTags) ELBv2Tags() []*elbv2.Tag {
+	result := make([]*elbv2.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elbv2.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
+}
+
+func keyValueTagsFromDynamoDb(ts []*dynamodb.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	return tagsFromMapDynamoDb(create), remove
+
+	return tags
+}
+
+// DynamoDbTags returns the tags as DynamoDB tags.
+func (tags keyValueTags) DynamoDbTags() []*dynamodb.Tag {
+	result := make([]*dynamodb.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dynamodb.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
 }
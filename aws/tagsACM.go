@@ -1,103 +1,84 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acm"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func setTagsACM(conn *acm.ACM, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsACM(tagsFromMapACM(o), tagsFromMapACM(n))
+	return tagsServiceACM(conn).UpdateResource(d, d.Get("arn").(string))
+}
 
-		// Set tags
-		if len(remove) > 0 {
-			input := acm.RemoveTagsFromCertificateInput{
-				CertificateArn: aws.String(d.Get("arn").(string)),
-				Tags:           remove,
-			}
-			log.Printf("[DEBUG] Removing ACM tags: %s", input)
-			_, err := conn.RemoveTagsFromCertificate(&input)
+// tagsServiceACM returns the calls that manage the tags of an ACM certificate.
+func tagsServiceACM(conn *acm.ACM) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.ListTagsForCertificate(&acm.ListTagsForCertificateInput{
+				CertificateArn: aws.String(identifier),
+			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-		if len(create) > 0 {
-			input := acm.AddTagsToCertificateInput{
-				CertificateArn: aws.String(d.Get("arn").(string)),
-				Tags:           create,
-			}
-			log.Printf("[DEBUG] Adding ACM tags: %s", input)
-			_, err := conn.AddTagsToCertificate(&input)
-			if err != nil {
-				return err
-			}
-		}
+			return keyValueTagsFromACM(resp.Tags), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.AddTagsToCertificate(&acm.AddTagsToCertificateInput{
+				CertificateArn: aws.String(identifier),
+				Tags:           tags.ACMTags(),
+			})
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromCertificate(&acm.RemoveTagsFromCertificateInput{
+				CertificateArn: aws.String(identifier),
+				Tags:           tags.ACMTags(),
+			})
+			return err
+		},
 	}
-
-	return nil
 }
 
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsACM(oldTags, newTags []*acm.Tag) ([]*acm.Tag, []*acm.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*acm.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapACM(create), remove
+	o, n := keyValueTagsFromACM(oldTags), keyValueTagsFromACM(newTags)
+	return o.Updated(n).ACMTags(), o.Removed(n).ACMTags()
 }
 
 func tagsFromMapACM(m map[string]interface{}) []*acm.Tag {
-	result := []*acm.Tag{}
-	for k, v := range m {
-		result = append(result, &acm.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().ACMTags()
 }
 
 func tagsToMapACM(ts []*acm.Tag) map[string]string {
-	result := map[string]string{}
-	for _, t := range ts {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
+	return keyValueTagsFromACM(ts).IgnoreAws().Map()
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredACM(t *acm.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+	return tagKeyIgnoredAws(aws.StringValue(t.Key))
+}
+
+func keyValueTagsFromACM(ts []*acm.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// ACMTags returns the tags as ACM tags.
+func (tags keyValueTags) ACMTags() []*acm.Tag {
+	result := make([]*acm.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &acm.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
-	return false
+
+	return result
 }
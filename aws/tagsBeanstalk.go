@@ -2,72 +2,90 @@ package aws
 
 import (
 	"log"
-	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 )
 
 // diffTags takes our tags locally and the ones remotely and returns
-// the set of tags that must be created, and the set of tags that must
-// be destroyed.
+// the set of tags that must be created, and the names of the tags that
+// must be destroyed. Elastic Beanstalk adds and removes tags in a single
+// call, so tags whose value has changed are only updated.
 func diffTagsBeanstalk(oldTags, newTags []*elasticbeanstalk.Tag) ([]*elasticbeanstalk.Tag, []*string) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
+	o, n := keyValueTagsFromBeanstalk(oldTags), keyValueTagsFromBeanstalk(newTags)
 
-	// Build the list of what to remove
 	var remove []*string
-	for _, t := range oldTags {
-		if _, ok := create[*t.Key]; !ok {
-			// Delete it!
-			remove = append(remove, t.Key)
+	for _, k := range o.Removed(n).Keys() {
+		if _, ok := n[k]; !ok {
+			remove = append(remove, aws.String(k))
 		}
 	}
 
-	return tagsFromMapBeanstalk(create), remove
+	return o.Updated(n).BeanstalkTags(), remove
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapBeanstalk(m map[string]interface{}) []*elasticbeanstalk.Tag {
-	var result []*elasticbeanstalk.Tag
-	for k, v := range m {
-		t := &elasticbeanstalk.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredBeanstalk(t) {
-			result = append(result, t)
+	return newKeyValueTags(m).IgnoreBeanstalk().BeanstalkTags()
+}
+
+// tagsToMap turns the list of tags into a map.
+func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
+	return keyValueTagsFromBeanstalk(ts).IgnoreBeanstalk().Map()
+}
+
+// compare a tag against a list of strings and checks if it should
+// be ignored or not
+func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
+	return tagKeyIgnoredBeanstalk(aws.StringValue(t.Key))
+}
+
+// tagKeyIgnoredBeanstalk returns whether the given tag key is reserved by AWS
+// or set by Elastic Beanstalk itself.
+func tagKeyIgnoredBeanstalk(k string) bool {
+	if tagKeyIgnoredAws(k) {
+		return true
+	}
+	if strings.HasPrefix(k, "elasticbeanstalk:") || strings.Contains(k, "Name") {
+		log.Printf("[DEBUG] Found Elastic Beanstalk specific tag %s, ignoring.", k)
+		return true
+	}
+
+	return false
+}
+
+// IgnoreBeanstalk returns the tags without those reserved by AWS or set by
+// Elastic Beanstalk itself.
+func (tags keyValueTags) IgnoreBeanstalk() keyValueTags {
+	result := make(keyValueTags, len(tags))
+	for k, v := range tags {
+		if !tagKeyIgnoredBeanstalk(k) {
+			result[k] = v
 		}
 	}
 
 	return result
 }
 
-// tagsToMap turns the list of tags into a map.
-func tagsToMapBeanstalk(ts []*elasticbeanstalk.Tag) map[string]string {
-	result := make(map[string]string)
+func keyValueTagsFromBeanstalk(ts []*elasticbeanstalk.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
 	for _, t := range ts {
-		if !tagIgnoredBeanstalk(t) {
-			result[*t.Key] = *t.Value
-		}
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return result
+	return tags
 }
 
-// compare a tag against a list of strings and checks if it should
-// be ignored or not
-func tagIgnoredBeanstalk(t *elasticbeanstalk.Tag) bool {
-	filter := []string{"^aws:", "^elasticbeanstalk:", "Name"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+// BeanstalkTags returns the tags as Elastic Beanstalk tags.
+func (tags keyValueTags) BeanstalkTags() []*elasticbeanstalk.Tag {
+	result := make([]*elasticbeanstalk.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &elasticbeanstalk.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
 	}
-	return false
+
+	return result
 }
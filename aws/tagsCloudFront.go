@@ -1,98 +1,78 @@
 package aws
 
 import (
-	"log"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudfront"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func setTagsCloudFront(conn *cloudfront.CloudFront, d *schema.ResourceData, arn string) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-		create, remove := diffTagsCloudFront(tagsFromMapCloudFront(o), tagsFromMapCloudFront(n))
-
-		if len(remove) > 0 {
-			log.Printf("[DEBUG] Removing tags: %s", remove)
-			k := make([]*string, 0, len(remove))
-			for _, t := range remove {
-				k = append(k, t.Key)
-			}
+	return tagsServiceCloudFront(conn).UpdateResource(d, arn)
+}
 
-			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
-				Resource: aws.String(arn),
-				TagKeys: &cloudfront.TagKeys{
-					Items: k,
-				},
+// tagsServiceCloudFront returns the calls that manage the tags of a CloudFront
+// distribution.
+func tagsServiceCloudFront(conn *cloudfront.CloudFront) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.ListTagsForResource(&cloudfront.ListTagsForResourceInput{
+				Resource: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-
-		if len(create) > 0 {
-			log.Printf("[DEBUG] Creating tags: %s", create)
+			return keyValueTagsFromCloudFront(resp.Tags), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
 			_, err := conn.TagResource(&cloudfront.TagResourceInput{
-				Resource: aws.String(arn),
-				Tags: &cloudfront.Tags{
-					Items: create,
+				Resource: aws.String(identifier),
+				Tags:     tags.CloudFrontTags(),
+			})
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.UntagResource(&cloudfront.UntagResourceInput{
+				Resource: aws.String(identifier),
+				TagKeys: &cloudfront.TagKeys{
+					Items: aws.StringSlice(tags.Keys()),
 				},
 			})
-			if err != nil {
-				return err
-			}
-		}
-
+			return err
+		},
 	}
+}
 
-	return nil
+func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
+	return newKeyValueTags(m).IgnoreAws().CloudFrontTags()
 }
-func diffTagsCloudFront(oldTags, newTags *cloudfront.Tags) ([]*cloudfront.Tag, []*cloudfront.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags.Items {
-		create[*t.Key] = *t.Value
-	}
 
-	// Build the list of what to remove
-	var remove []*cloudfront.Tag
-	for _, t := range oldTags.Items {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
+func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
+	return keyValueTagsFromCloudFront(ts).IgnoreAws().Map()
+}
+
+func keyValueTagsFromCloudFront(ts *cloudfront.Tags) keyValueTags {
+	tags := make(keyValueTags)
+	if ts == nil {
+		return tags
+	}
+	for _, t := range ts.Items {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	createTags := tagsFromMapCloudFront(create)
-	return createTags.Items, remove
+	return tags
 }
 
-func tagsFromMapCloudFront(m map[string]interface{}) *cloudfront.Tags {
-	result := make([]*cloudfront.Tag, 0, len(m))
-	for k, v := range m {
+// CloudFrontTags returns the tags as CloudFront tags.
+func (tags keyValueTags) CloudFrontTags() *cloudfront.Tags {
+	result := make([]*cloudfront.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
 		result = append(result, &cloudfront.Tag{
 			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
+			Value: aws.String(tags[k]),
 		})
 	}
 
-	tags := &cloudfront.Tags{
+	return &cloudfront.Tags{
 		Items: result,
 	}
-
-	return tags
-}
-
-func tagsToMapCloudFront(ts *cloudfront.Tags) map[string]string {
-	result := make(map[string]string)
-
-	for _, t := range ts.Items {
-		result[*t.Key] = *t.Value
-	}
-
-	return result
 }
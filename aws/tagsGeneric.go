@@ -1,9 +1,6 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 )
 
@@ -11,59 +8,39 @@ import (
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsGeneric(oldTags, newTags map[string]interface{}) (map[string]*string, map[string]*string) {
-	// First, we're creating everything we have
-	create := make(map[string]*string)
-	for k, v := range newTags {
-		create[k] = aws.String(v.(string))
-	}
-
-	// Build the map of what to remove
-	remove := make(map[string]*string)
-	for k, v := range oldTags {
-		old, ok := create[k]
-		if !ok || old != aws.String(v.(string)) {
-			// Delete it!
-			remove[k] = aws.String(v.(string))
-		}
-	}
-
-	return create, remove
+	o, n := newKeyValueTags(oldTags).IgnoreAws(), newKeyValueTags(newTags).IgnoreAws()
+	return o.Updated(n).GenericTags(), o.Removed(n).GenericTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapGeneric(m map[string]interface{}) map[string]*string {
-	result := make(map[string]*string)
-	for k, v := range m {
-		if !tagIgnoredGeneric(k) {
-			result[k] = aws.String(v.(string))
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().GenericTags()
 }
 
 // tagsToMap turns the tags into a map.
 func tagsToMapGeneric(ts map[string]*string) map[string]string {
-	result := make(map[string]string)
-	for k, v := range ts {
-		if !tagIgnoredGeneric(k) {
-			result[k] = aws.StringValue(v)
-		}
-	}
-
-	return result
+	return keyValueTagsFromGeneric(ts).IgnoreAws().Map()
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredGeneric(k string) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, k)
-		if r, _ := regexp.MatchString(v, k); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.\n", k)
-			return true
-		}
+	return tagKeyIgnoredAws(k)
+}
+
+// keyValueTagsFromGeneric returns the tags of services that represent them
+// as a map of tag key to value.
+func keyValueTagsFromGeneric(ts map[string]*string) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for k, v := range ts {
+		tags[k] = aws.StringValue(v)
 	}
-	return false
+
+	return tags
+}
+
+// GenericTags returns the tags as a map of tag key to value, the form used
+// by services such as Lambda and SQS.
+func (tags keyValueTags) GenericTags() map[string]*string {
+	return aws.StringMap(tags.Map())
 }
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+)
+
+// tagsServiceSageMaker returns the calls that manage the tags of a SageMaker
+// resource.
+func tagsServiceSageMaker(conn *sagemaker.SageMaker) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			var ts []*sagemaker.Tag
+			err := conn.ListTagsPages(&sagemaker.ListTagsInput{
+				ResourceArn: aws.String(identifier),
+			}, func(page *sagemaker.ListTagsOutput, lastPage bool) bool {
+				ts = append(ts, page.Tags...)
+				return !lastPage
+			})
+			if err != nil {
+				return nil, err
+			}
+			return keyValueTagsFromSageMaker(ts), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.AddTags(&sagemaker.AddTagsInput{
+				ResourceArn: aws.String(identifier),
+				Tags:        tags.SageMakerTags(),
+			})
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.DeleteTags(&sagemaker.DeleteTagsInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+	}
+}
+
+// tagsFromMap returns the tags for the given map of data.
+func tagsFromMapSageMaker(m map[string]interface{}) []*sagemaker.Tag {
+	return newKeyValueTags(m).IgnoreAws().SageMakerTags()
+}
+
+func keyValueTagsFromSageMaker(ts []*sagemaker.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	return tags
+}
+
+// SageMakerTags returns the tags as SageMaker tags.
+func (tags keyValueTags) SageMakerTags() []*sagemaker.Tag {
+	result := make([]*sagemaker.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &sagemaker.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
+}
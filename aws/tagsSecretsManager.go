@@ -1,74 +1,82 @@
 package aws
 
 import (
-	"log"
-	"regexp"
-
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
 )
 
+// tagsServiceSecretsManager returns the calls that manage the tags of a
+// Secrets Manager secret.
+func tagsServiceSecretsManager(conn *secretsmanager.SecretsManager) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.DescribeSecret(&secretsmanager.DescribeSecretInput{
+				SecretId: aws.String(identifier),
+			})
+			if err != nil {
+				return nil, err
+			}
+			return keyValueTagsFromSecretsManager(resp.Tags), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.TagResource(&secretsmanager.TagResourceInput{
+				SecretId: aws.String(identifier),
+				Tags:     tags.SecretsManagerTags(),
+			})
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.UntagResource(&secretsmanager.UntagResourceInput{
+				SecretId: aws.String(identifier),
+				TagKeys:  aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+	}
+}
+
 // diffTags takes our tags locally and the ones remotely and returns
 // the set of tags that must be created, and the set of tags that must
 // be destroyed.
 func diffTagsSecretsManager(oldTags, newTags []*secretsmanager.Tag) ([]*secretsmanager.Tag, []*secretsmanager.Tag) {
-	// First, we're creating everything we have
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	// Build the list of what to remove
-	var remove []*secretsmanager.Tag
-	for _, t := range oldTags {
-		old, ok := create[*t.Key]
-		if !ok || old != *t.Value {
-			// Delete it!
-			remove = append(remove, t)
-		}
-	}
-
-	return tagsFromMapSecretsManager(create), remove
+	o, n := keyValueTagsFromSecretsManager(oldTags), keyValueTagsFromSecretsManager(newTags)
+	return o.Updated(n).SecretsManagerTags(), o.Removed(n).SecretsManagerTags()
 }
 
 // tagsFromMap returns the tags for the given map of data.
 func tagsFromMapSecretsManager(m map[string]interface{}) []*secretsmanager.Tag {
-	result := make([]*secretsmanager.Tag, 0, len(m))
-	for k, v := range m {
-		t := &secretsmanager.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		}
-		if !tagIgnoredSecretsManager(t) {
-			result = append(result, t)
-		}
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().SecretsManagerTags()
 }
 
 // tagsToMap turns the list of tags into a map.
 func tagsToMapSecretsManager(ts []*secretsmanager.Tag) map[string]string {
-	result := make(map[string]string)
-	for _, t := range ts {
-		if !tagIgnoredSecretsManager(t) {
-			result[*t.Key] = *t.Value
-		}
-	}
-
-	return result
+	return keyValueTagsFromSecretsManager(ts).IgnoreAws().Map()
 }
 
 // compare a tag against a list of strings and checks if it should
 // be ignored or not
 func tagIgnoredSecretsManager(t *secretsmanager.Tag) bool {
-	filter := []string{"^aws:"}
-	for _, v := range filter {
-		log.Printf("[DEBUG] Matching %v with %v\n", v, *t.Key)
-		if r, _ := regexp.MatchString(v, *t.Key); r == true {
-			log.Printf("[DEBUG] Found AWS specific tag %s (val: %s), ignoring.\n", *t.Key, *t.Value)
-			return true
-		}
+	return tagKeyIgnoredAws(aws.StringValue(t.Key))
+}
+
+func keyValueTagsFromSecretsManager(ts []*secretsmanager.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
-	return false
+
+	return tags
+}
+
+// SecretsManagerTags returns the tags as Secrets Manager tags.
+func (tags keyValueTags) SecretsManagerTags() []*secretsmanager.Tag {
+	result := make([]*secretsmanager.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &secretsmanager.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
 }
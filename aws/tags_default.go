@@ -0,0 +1,183 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// defaultTagsSchema returns the schema for the provider-level default_tags
+// block.
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+		Description: descriptions["default_tags"],
+	}
+}
+
+// tagsSchemaAll returns the schema to use for tags_all, the computed
+// combination of a resource's own tags and the provider default_tags.
+func tagsSchemaAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// expandDefaultTags returns the tags configured in the provider default_tags
+// block, or nil if the block is absent.
+func expandDefaultTags(l []interface{}) map[string]interface{} {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	if v, ok := m["tags"].(map[string]interface{}); ok && len(v) > 0 {
+		return v
+	}
+
+	return nil
+}
+
+// mergeDefaultTags returns the provider default tags overlaid with the
+// resource tags. Resource tags win when both define the same key.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for k, v := range defaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// withoutDefaultTags returns the tags that do not originate from the provider
+// default_tags, i.e. all tags except those whose key and value match a
+// default tag that is not also present in the configured resource tags.
+func withoutDefaultTags(tags, defaultTags, configuredTags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if dv, ok := defaultTags[k]; ok && dv == v {
+			if _, ok := configuredTags[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// defaultTagsFromMeta returns the provider default tags, less any that are
+// excluded by the provider ignore_tags.
+func defaultTagsFromMeta(meta interface{}) map[string]interface{} {
+	if client, ok := meta.(*AWSClient); ok {
+		return withoutIgnoredTags(client.defaultTags, client.ignoreTagsConfig, nil)
+	}
+	return nil
+}
+
+// resourceWithDefaultTags adds the computed tags_all attribute to a resource
+// whose tags attribute is a map, and wraps its CRUD functions so that the
+// provider default_tags are planned into tags_all and filtered back out of
+// tags when the resource is read.
+//
+// The per-service tag helpers create and diff tags_all rather than tags, so
+// resources registered here send their default tags to AWS as well.
+func resourceWithDefaultTags(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Optional {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+
+	r.Schema["tags_all"] = tagsSchemaAll()
+
+	// Resources that cannot update their tags in place only pick up changes
+	// to the default tags when they are recreated.
+	updatable := r.Update != nil && !s.ForceNew
+	if r.CustomizeDiff != nil {
+		r.CustomizeDiff = customdiff.Sequence(r.CustomizeDiff, resourceTagsAllCustomizeDiff(updatable))
+	} else {
+		r.CustomizeDiff = resourceTagsAllCustomizeDiff(updatable)
+	}
+
+	r.Create = resourceTagsAllRefresh(r.Create)
+	r.Read = resourceTagsAllRefresh(r.Read)
+	if r.Update != nil {
+		r.Update = resourceTagsAllRefresh(r.Update)
+	}
+}
+
+// resourceTagsAllCustomizeDiff plans tags_all as the merge of the provider
+// default_tags and the configured resource tags.
+func resourceTagsAllCustomizeDiff(updatable bool) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if !updatable && diff.Id() != "" {
+			return nil
+		}
+
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+
+		tagsAll := mergeDefaultTags(defaultTagsFromMeta(meta), diff.Get("tags").(map[string]interface{}))
+		if reflect.DeepEqual(diff.Get("tags_all"), tagsAll) {
+			return nil
+		}
+
+		return diff.SetNew("tags_all", tagsAll)
+	}
+}
+
+// resourceTagsAllRefresh wraps a create, read or update function. Once the
+// wrapped function has stored the tags found on the resource, those excluded
+// by the provider ignore_tags are dropped, the rest are copied to tags_all and
+// the ones supplied by the provider default_tags are removed from tags so that
+// they do not show up as a diff against the configuration.
+func resourceTagsAllRefresh(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		tagsAll := withoutIgnoredTags(d.Get("tags").(map[string]interface{}), ignoreTagsFromMeta(meta), configuredTags)
+		tags := withoutDefaultTags(tagsAll, defaultTagsFromMeta(meta), configuredTags)
+		if len(tags) != len(tagsAll) {
+			log.Printf("[DEBUG] Removing provider default tags from %s tags", d.Id())
+		}
+
+		if err := d.Set("tags", tags); err != nil {
+			return fmt.Errorf("error setting tags: %s", err)
+		}
+		if err := d.Set("tags_all", tagsAll); err != nil {
+			return fmt.Errorf("error setting tags_all: %s", err)
+		}
+
+		return nil
+	}
+}
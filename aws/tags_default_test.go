@@ -0,0 +1,195 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestMergeDefaultTags(t *testing.T) {
+	cases := []struct {
+		Default, Tags, Expected map[string]interface{}
+	}{
+		// No default tags
+		{
+			Default: nil,
+			Tags: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// No resource tags
+		{
+			Default: map[string]interface{}{
+				"Environment": "Production",
+			},
+			Tags: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Environment": "Production",
+			},
+		},
+
+		// Resource tags override default tags
+		{
+			Default: map[string]interface{}{
+				"Environment": "Production",
+				"Owner":       "Ops",
+			},
+			Tags: map[string]interface{}{
+				"Name":  "foo",
+				"Owner": "Network",
+			},
+			Expected: map[string]interface{}{
+				"Environment": "Production",
+				"Name":        "foo",
+				"Owner":       "Network",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := mergeDefaultTags(tc.Default, tc.Tags)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestWithoutDefaultTags(t *testing.T) {
+	cases := []struct {
+		Tags, Default, Configured, Expected map[string]interface{}
+	}{
+		// Default tag removed
+		{
+			Tags: map[string]interface{}{
+				"Environment": "Production",
+				"Name":        "foo",
+			},
+			Default: map[string]interface{}{
+				"Environment": "Production",
+			},
+			Configured: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// Default tag with a different value is kept
+		{
+			Tags: map[string]interface{}{
+				"Environment": "Staging",
+			},
+			Default: map[string]interface{}{
+				"Environment": "Production",
+			},
+			Configured: map[string]interface{}{},
+			Expected: map[string]interface{}{
+				"Environment": "Staging",
+			},
+		},
+
+		// Default tag also configured on the resource is kept
+		{
+			Tags: map[string]interface{}{
+				"Environment": "Production",
+			},
+			Default: map[string]interface{}{
+				"Environment": "Production",
+			},
+			Configured: map[string]interface{}{
+				"Environment": "Production",
+			},
+			Expected: map[string]interface{}{
+				"Environment": "Production",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := withoutDefaultTags(tc.Tags, tc.Default, tc.Configured)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestResourceWithDefaultTags(t *testing.T) {
+	for name, r := range Provider().(*schema.Provider).ResourcesMap {
+		s, ok := r.Schema["tags"]
+		if !ok || s.Type != schema.TypeMap || !s.Optional {
+			continue
+		}
+
+		all, ok := r.Schema["tags_all"]
+		if !ok {
+			t.Fatalf("%s: expected tags_all attribute", name)
+		}
+		if all.Type != schema.TypeMap || !all.Computed || all.Optional {
+			t.Fatalf("%s: bad tags_all schema: %#v", name, all)
+		}
+		if r.CustomizeDiff == nil {
+			t.Fatalf("%s: expected CustomizeDiff", name)
+		}
+	}
+}
+
+func TestResourceTagsAllRefresh(t *testing.T) {
+	remoteTags := map[string]interface{}{
+		"Environment": "Production",
+		"Name":        "foo",
+		"Owner":       "Network",
+	}
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return d.Set("tags", remoteTags)
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Create: read,
+		Read:   read,
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+
+	resourceWithDefaultTags(r)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name":  "foo",
+			"Owner": "Network",
+		},
+	})
+	d.SetId("foo")
+
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Environment": "Production",
+			"Owner":       "Ops",
+		},
+	}
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expectedTags := map[string]interface{}{
+		"Name":  "foo",
+		"Owner": "Network",
+	}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("bad tags: %#v", actual)
+	}
+	if actual := d.Get("tags_all"); !reflect.DeepEqual(actual, remoteTags) {
+		t.Fatalf("bad tags_all: %#v", actual)
+	}
+}
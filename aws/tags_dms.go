@@ -7,43 +7,16 @@ import (
 )
 
 func dmsTagsToMap(tags []*dms.Tag) map[string]string {
-	result := make(map[string]string)
-
-	for _, tag := range tags {
-		result[*tag.Key] = *tag.Value
-	}
-
-	return result
+	return keyValueTagsFromDMS(tags).IgnoreAws().Map()
 }
 
 func dmsTagsFromMap(m map[string]interface{}) []*dms.Tag {
-	result := make([]*dms.Tag, 0, len(m))
-
-	for k, v := range m {
-		result = append(result, &dms.Tag{
-			Key:   aws.String(k),
-			Value: aws.String(v.(string)),
-		})
-	}
-
-	return result
+	return newKeyValueTags(m).IgnoreAws().DMSTags()
 }
 
 func dmsDiffTags(oldTags, newTags []*dms.Tag) ([]*dms.Tag, []*dms.Tag) {
-	create := make(map[string]interface{})
-	for _, t := range newTags {
-		create[*t.Key] = *t.Value
-	}
-
-	remove := []*dms.Tag{}
-	for _, t := range oldTags {
-		v, ok := create[*t.Key]
-		if !ok || v != *t.Value {
-			remove = append(remove, t)
-		}
-	}
-
-	return dmsTagsFromMap(create), remove
+	o, n := keyValueTagsFromDMS(oldTags), keyValueTagsFromDMS(newTags)
+	return o.Updated(n).DMSTags(), o.Removed(n).DMSTags()
 }
 
 func dmsGetTagKeys(tags []*dms.Tag) []*string {
@@ -57,35 +30,57 @@ func dmsGetTagKeys(tags []*dms.Tag) []*string {
 }
 
 func dmsSetTags(arn string, d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*AWSClient).dmsconn
-
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
-
-		add, remove := dmsDiffTags(dmsTagsFromMap(o), dmsTagsFromMap(n))
+	return tagsServiceDMS(meta.(*AWSClient).dmsconn).UpdateResource(d, arn)
+}
 
-		if len(remove) > 0 {
-			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
-				ResourceArn: aws.String(arn),
-				TagKeys:     dmsGetTagKeys(remove),
+// tagsServiceDMS returns the calls that manage the tags of a Database
+// Migration Service resource.
+func tagsServiceDMS(conn *dms.DatabaseMigrationService) keyValueTagsService {
+	return keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			resp, err := conn.ListTagsForResource(&dms.ListTagsForResourceInput{
+				ResourceArn: aws.String(identifier),
 			})
 			if err != nil {
-				return err
+				return nil, err
 			}
-		}
-
-		if len(add) > 0 {
+			return keyValueTagsFromDMS(resp.TagList), nil
+		},
+		Tag: func(identifier string, tags keyValueTags) error {
 			_, err := conn.AddTagsToResource(&dms.AddTagsToResourceInput{
-				ResourceArn: aws.String(arn),
-				Tags:        add,
+				ResourceArn: aws.String(identifier),
+				Tags:        tags.DMSTags(),
 			})
-			if err != nil {
-				return err
-			}
-		}
+			return err
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			_, err := conn.RemoveTagsFromResource(&dms.RemoveTagsFromResourceInput{
+				ResourceArn: aws.String(identifier),
+				TagKeys:     aws.StringSlice(tags.Keys()),
+			})
+			return err
+		},
+	}
+}
+
+func keyValueTagsFromDMS(ts []*dms.Tag) keyValueTags {
+	tags := make(keyValueTags, len(ts))
+	for _, t := range ts {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
 	}
 
-	return nil
+	return tags
+}
+
+// DMSTags returns the tags as Database Migration Service tags.
+func (tags keyValueTags) DMSTags() []*dms.Tag {
+	result := make([]*dms.Tag, 0, len(tags))
+	for _, k := range tags.Keys() {
+		result = append(result, &dms.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(tags[k]),
+		})
+	}
+
+	return result
 }
@@ -0,0 +1,143 @@
+package aws
+
+import (
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ignoreTagsConfig holds the tag keys and key prefixes that the provider
+// ignore_tags block excludes from management.
+type ignoreTagsConfig struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// ignoreTagsSchema returns the schema for the provider-level ignore_tags
+// block.
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+		Description: descriptions["ignore_tags"],
+	}
+}
+
+// expandIgnoreTags returns the provider ignore_tags configuration, or nil if
+// the block is absent or empty.
+func expandIgnoreTags(l []interface{}) *ignoreTagsConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	m := l[0].(map[string]interface{})
+	config := &ignoreTagsConfig{}
+	if v, ok := m["keys"].(*schema.Set); ok {
+		config.Keys = aws.StringValueSlice(expandStringSet(v))
+	}
+	if v, ok := m["key_prefixes"].(*schema.Set); ok {
+		config.KeyPrefixes = aws.StringValueSlice(expandStringSet(v))
+	}
+
+	if len(config.Keys) == 0 && len(config.KeyPrefixes) == 0 {
+		return nil
+	}
+
+	return config
+}
+
+// ignored returns whether the given tag key is excluded by the configuration.
+func (c *ignoreTagsConfig) ignored(k string) bool {
+	if c == nil {
+		return false
+	}
+
+	for _, key := range c.Keys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range c.KeyPrefixes {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ignoreTagsFromMeta(meta interface{}) *ignoreTagsConfig {
+	if client, ok := meta.(*AWSClient); ok {
+		return client.ignoreTagsConfig
+	}
+	return nil
+}
+
+// withoutIgnoredTags returns the tags whose keys are not excluded by the
+// provider ignore_tags. Keys that are explicitly configured on the resource
+// are always kept so that they can still be managed.
+func withoutIgnoredTags(tags map[string]interface{}, ignoreTags *ignoreTagsConfig, configuredTags map[string]interface{}) map[string]interface{} {
+	if ignoreTags == nil {
+		return tags
+	}
+
+	result := make(map[string]interface{}, len(tags))
+	for k, v := range tags {
+		if ignoreTags.ignored(k) {
+			if _, ok := configuredTags[k]; !ok {
+				log.Printf("[DEBUG] Ignoring tag %s as configured in the provider ignore_tags", k)
+				continue
+			}
+		}
+		result[k] = v
+	}
+
+	return result
+}
+
+// dataSourceWithIgnoreTags wraps the read function of a data source whose
+// tags attribute is a map so that the tags excluded by the provider
+// ignore_tags are not returned.
+func dataSourceWithIgnoreTags(r *schema.Resource) {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Computed {
+		return
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		configuredTags := d.Get("tags").(map[string]interface{})
+
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		ignoreTags := ignoreTagsFromMeta(meta)
+		if ignoreTags == nil || d.Id() == "" {
+			return nil
+		}
+
+		return d.Set("tags", withoutIgnoredTags(d.Get("tags").(map[string]interface{}), ignoreTags, configuredTags))
+	}
+}
@@ -0,0 +1,188 @@
+package aws
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestIgnoreTagsConfigIgnored(t *testing.T) {
+	config := &ignoreTagsConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"kubernetes.io/cluster/"},
+	}
+
+	cases := []struct {
+		Config   *ignoreTagsConfig
+		Key      string
+		Expected bool
+	}{
+		{
+			Config:   nil,
+			Key:      "CostCenter",
+			Expected: false,
+		},
+		{
+			Config:   config,
+			Key:      "CostCenter",
+			Expected: true,
+		},
+		{
+			Config:   config,
+			Key:      "CostCenterCode",
+			Expected: false,
+		},
+		{
+			Config:   config,
+			Key:      "kubernetes.io/cluster/example",
+			Expected: true,
+		},
+		{
+			Config:   config,
+			Key:      "Name",
+			Expected: false,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := tc.Config.ignored(tc.Key); actual != tc.Expected {
+			t.Fatalf("%d: expected %t for %q, got %t", i, tc.Expected, tc.Key, actual)
+		}
+	}
+}
+
+func TestWithoutIgnoredTags(t *testing.T) {
+	config := &ignoreTagsConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"kubernetes.io/cluster/"},
+	}
+
+	cases := []struct {
+		Tags, Configured, Expected map[string]interface{}
+	}{
+		// Ignored keys and prefixes removed
+		{
+			Tags: map[string]interface{}{
+				"CostCenter":                    "1234",
+				"Name":                          "foo",
+				"kubernetes.io/cluster/example": "owned",
+			},
+			Configured: map[string]interface{}{
+				"Name": "foo",
+			},
+			Expected: map[string]interface{}{
+				"Name": "foo",
+			},
+		},
+
+		// Ignored keys configured on the resource are kept
+		{
+			Tags: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "foo",
+			},
+			Configured: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "foo",
+			},
+			Expected: map[string]interface{}{
+				"CostCenter": "1234",
+				"Name":       "foo",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		actual := withoutIgnoredTags(tc.Tags, config, tc.Configured)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestExpandIgnoreTags(t *testing.T) {
+	if v := expandIgnoreTags(nil); v != nil {
+		t.Fatalf("expected nil, got %#v", v)
+	}
+
+	if v := expandIgnoreTags([]interface{}{
+		map[string]interface{}{
+			"keys":         schema.NewSet(schema.HashString, []interface{}{}),
+			"key_prefixes": schema.NewSet(schema.HashString, []interface{}{}),
+		},
+	}); v != nil {
+		t.Fatalf("expected nil, got %#v", v)
+	}
+
+	expected := &ignoreTagsConfig{
+		Keys:        []string{"CostCenter"},
+		KeyPrefixes: []string{"kubernetes.io/cluster/"},
+	}
+	actual := expandIgnoreTags([]interface{}{
+		map[string]interface{}{
+			"keys":         schema.NewSet(schema.HashString, []interface{}{"CostCenter"}),
+			"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"kubernetes.io/cluster/"}),
+		},
+	})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %#v", actual)
+	}
+}
+
+func TestResourceTagsAllRefresh_ignoreTags(t *testing.T) {
+	read := func(d *schema.ResourceData, meta interface{}) error {
+		return d.Set("tags", map[string]interface{}{
+			"CostCenter":                    "1234",
+			"Environment":                   "Production",
+			"Name":                          "foo",
+			"kubernetes.io/cluster/example": "owned",
+		})
+	}
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+		Create: read,
+		Read:   read,
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+
+	resourceWithDefaultTags(r)
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Name": "foo",
+		},
+	})
+	d.SetId("foo")
+
+	meta := &AWSClient{
+		defaultTags: map[string]interface{}{
+			"Environment": "Production",
+		},
+		ignoreTagsConfig: &ignoreTagsConfig{
+			Keys:        []string{"CostCenter"},
+			KeyPrefixes: []string{"kubernetes.io/cluster/"},
+		},
+	}
+	if err := r.Read(d, meta); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	expectedTags := map[string]interface{}{
+		"Name": "foo",
+	}
+	if actual := d.Get("tags"); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("bad tags: %#v", actual)
+	}
+	expectedTagsAll := map[string]interface{}{
+		"Environment": "Production",
+		"Name":        "foo",
+	}
+	if actual := d.Get("tags_all"); !reflect.DeepEqual(actual, expectedTagsAll) {
+		t.Fatalf("bad tags_all: %#v", actual)
+	}
+}
@@ -0,0 +1,172 @@
+package aws
+
+import (
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// awsTagKeyPrefix is the prefix of tag keys that are reserved by AWS, such as
+// those added by CloudFormation. Tags with these keys cannot be modified and
+// are never managed by the provider.
+const awsTagKeyPrefix = "aws:"
+
+// keyValueTags is the service-independent form of a set of resource tags,
+// keyed by tag key. Each service converts its own tag structures to and from
+// it so that tags are diffed and filtered the same way everywhere.
+type keyValueTags map[string]string
+
+// newKeyValueTags returns the tags held in a Terraform tags map.
+func newKeyValueTags(m map[string]interface{}) keyValueTags {
+	tags := make(keyValueTags, len(m))
+	for k, v := range m {
+		tags[k] = v.(string)
+	}
+
+	return tags
+}
+
+// tagKeyIgnoredAws returns whether the given tag key is reserved by AWS.
+func tagKeyIgnoredAws(k string) bool {
+	if strings.HasPrefix(k, awsTagKeyPrefix) {
+		log.Printf("[DEBUG] Found AWS specific tag %s, ignoring.", k)
+		return true
+	}
+
+	return false
+}
+
+// IgnoreAws returns the tags without those whose keys are reserved by AWS.
+func (tags keyValueTags) IgnoreAws() keyValueTags {
+	result := make(keyValueTags, len(tags))
+	for k, v := range tags {
+		if !tagKeyIgnoredAws(k) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Keys returns the tag keys in sorted order.
+func (tags keyValueTags) Keys() []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Map returns the tags as a map of strings, suitable for d.Set.
+func (tags keyValueTags) Map() map[string]string {
+	result := make(map[string]string, len(tags))
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// Removed returns the tags that must be removed to turn tags into newTags:
+// those whose key is absent from newTags or whose value has changed.
+func (tags keyValueTags) Removed(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range tags {
+		if nv, ok := newTags[k]; !ok || nv != v {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Updated returns the tags that must be added to turn tags into newTags:
+// those whose key is absent from tags or whose value has changed.
+func (tags keyValueTags) Updated(newTags keyValueTags) keyValueTags {
+	result := make(keyValueTags)
+	for k, v := range newTags {
+		if ov, ok := tags[k]; !ok || ov != v {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Chunks splits the tags into groups of at most size tags, for services that
+// limit the number of tags accepted by a single call.
+func (tags keyValueTags) Chunks(size int) []keyValueTags {
+	var result []keyValueTags
+	chunk := make(keyValueTags, size)
+	for _, k := range tags.Keys() {
+		if len(chunk) == size {
+			result = append(result, chunk)
+			chunk = make(keyValueTags, size)
+		}
+		chunk[k] = tags[k]
+	}
+	if len(chunk) > 0 {
+		result = append(result, chunk)
+	}
+
+	return result
+}
+
+// keyValueTagsService holds the calls that a service uses to list, add and
+// remove the tags of one of its resources. The identifier passed to each call
+// is whatever the service uses to address the resource, usually its ARN.
+type keyValueTagsService struct {
+	List  func(identifier string) (keyValueTags, error)
+	Tag   func(identifier string, tags keyValueTags) error
+	Untag func(identifier string, tags keyValueTags) error
+}
+
+// Get returns the tags of a resource, less those reserved by AWS. Those
+// excluded by the provider ignore_tags are dropped afterwards by
+// resourceTagsAllRefresh, which keeps any that the resource configures.
+func (s keyValueTagsService) Get(identifier string) (keyValueTags, error) {
+	tags, err := s.List(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags.IgnoreAws(), nil
+}
+
+// Update removes and adds tags so that the tags of a resource change from
+// oldTagsMap to newTagsMap. Tags reserved by AWS are left alone.
+func (s keyValueTagsService) Update(identifier string, oldTagsMap, newTagsMap map[string]interface{}) error {
+	oldTags := newKeyValueTags(oldTagsMap).IgnoreAws()
+	newTags := newKeyValueTags(newTagsMap).IgnoreAws()
+
+	if removed := oldTags.Removed(newTags); len(removed) > 0 {
+		log.Printf("[DEBUG] Removing tags from %s: %s", identifier, removed.Keys())
+		if err := s.Untag(identifier, removed); err != nil {
+			return err
+		}
+	}
+
+	if updated := oldTags.Updated(newTags); len(updated) > 0 {
+		log.Printf("[DEBUG] Creating tags on %s: %s", identifier, updated.Keys())
+		if err := s.Tag(identifier, updated); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateResource updates the tags of a resource if its tags_all attribute,
+// the combination of its own tags and the provider default_tags, has changed.
+func (s keyValueTagsService) UpdateResource(d *schema.ResourceData, identifier string) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+
+	o, n := d.GetChange("tags_all")
+	return s.Update(identifier, o.(map[string]interface{}), n.(map[string]interface{}))
+}
@@ -0,0 +1,316 @@
+package aws
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+func TestKeyValueTagsIgnoreAws(t *testing.T) {
+	cases := []struct {
+		Tags, Expected keyValueTags
+	}{
+		{
+			Tags:     keyValueTags{},
+			Expected: keyValueTags{},
+		},
+		{
+			Tags: keyValueTags{
+				"Name": "foo",
+			},
+			Expected: keyValueTags{
+				"Name": "foo",
+			},
+		},
+		{
+			Tags: keyValueTags{
+				"aws:cloudformation:logical-id": "foo",
+				"aws:foo:bar":                   "baz",
+				"Name":                          "foo",
+				"notaws:foo":                    "bar",
+			},
+			Expected: keyValueTags{
+				"Name":       "foo",
+				"notaws:foo": "bar",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := tc.Tags.IgnoreAws(); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestKeyValueTagsDiff(t *testing.T) {
+	cases := []struct {
+		Old, New         keyValueTags
+		Removed, Updated keyValueTags
+	}{
+		// No change
+		{
+			Old:     keyValueTags{"foo": "bar"},
+			New:     keyValueTags{"foo": "bar"},
+			Removed: keyValueTags{},
+			Updated: keyValueTags{},
+		},
+
+		// Basic add/remove
+		{
+			Old:     keyValueTags{"foo": "bar"},
+			New:     keyValueTags{"bar": "baz"},
+			Removed: keyValueTags{"foo": "bar"},
+			Updated: keyValueTags{"bar": "baz"},
+		},
+
+		// Modify
+		{
+			Old:     keyValueTags{"foo": "bar"},
+			New:     keyValueTags{"foo": "baz"},
+			Removed: keyValueTags{"foo": "bar"},
+			Updated: keyValueTags{"foo": "baz"},
+		},
+
+		// Unchanged tags are left alone
+		{
+			Old:     keyValueTags{"foo": "bar", "Name": "test"},
+			New:     keyValueTags{"Name": "test", "bar": "baz"},
+			Removed: keyValueTags{"foo": "bar"},
+			Updated: keyValueTags{"bar": "baz"},
+		},
+
+		// Empty value
+		{
+			Old:     keyValueTags{"foo": "bar"},
+			New:     keyValueTags{"foo": ""},
+			Removed: keyValueTags{"foo": "bar"},
+			Updated: keyValueTags{"foo": ""},
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := tc.Old.Removed(tc.New); !reflect.DeepEqual(actual, tc.Removed) {
+			t.Fatalf("%d: bad removed: %#v", i, actual)
+		}
+		if actual := tc.Old.Updated(tc.New); !reflect.DeepEqual(actual, tc.Updated) {
+			t.Fatalf("%d: bad updated: %#v", i, actual)
+		}
+	}
+}
+
+func TestKeyValueTagsChunks(t *testing.T) {
+	cases := []struct {
+		Tags     keyValueTags
+		Size     int
+		Expected []keyValueTags
+	}{
+		{
+			Tags:     keyValueTags{},
+			Size:     2,
+			Expected: nil,
+		},
+		{
+			Tags: keyValueTags{"a": "1", "b": "2"},
+			Size: 2,
+			Expected: []keyValueTags{
+				{"a": "1", "b": "2"},
+			},
+		},
+		{
+			Tags: keyValueTags{"a": "1", "b": "2", "c": "3", "d": "4", "e": "5"},
+			Size: 2,
+			Expected: []keyValueTags{
+				{"a": "1", "b": "2"},
+				{"c": "3", "d": "4"},
+				{"e": "5"},
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := tc.Tags.Chunks(tc.Size); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("%d: bad: %#v", i, actual)
+		}
+	}
+}
+
+func TestKeyValueTagsServiceUpdate(t *testing.T) {
+	cases := []struct {
+		Old, New         map[string]interface{}
+		Removed, Updated keyValueTags
+	}{
+		// No change
+		{
+			Old: map[string]interface{}{"foo": "bar"},
+			New: map[string]interface{}{"foo": "bar"},
+		},
+
+		// Add, remove and modify
+		{
+			Old: map[string]interface{}{
+				"foo":  "bar",
+				"Name": "test",
+				"gone": "yes",
+			},
+			New: map[string]interface{}{
+				"foo":  "baz",
+				"Name": "test",
+				"new":  "yes",
+			},
+			Removed: keyValueTags{"foo": "bar", "gone": "yes"},
+			Updated: keyValueTags{"foo": "baz", "new": "yes"},
+		},
+
+		// Tags reserved by AWS are never modified
+		{
+			Old: map[string]interface{}{
+				"aws:cloudformation:stack-name": "foo",
+			},
+			New: map[string]interface{}{
+				"aws:cloudformation:stack-name": "bar",
+			},
+		},
+	}
+
+	for i, tc := range cases {
+		var removed, updated keyValueTags
+		service := keyValueTagsService{
+			Tag: func(identifier string, tags keyValueTags) error {
+				if identifier != "example" {
+					t.Fatalf("%d: bad identifier: %s", i, identifier)
+				}
+				updated = tags
+				return nil
+			},
+			Untag: func(identifier string, tags keyValueTags) error {
+				if identifier != "example" {
+					t.Fatalf("%d: bad identifier: %s", i, identifier)
+				}
+				removed = tags
+				return nil
+			},
+		}
+
+		if err := service.Update("example", tc.Old, tc.New); err != nil {
+			t.Fatalf("%d: err: %s", i, err)
+		}
+		if !reflect.DeepEqual(removed, tc.Removed) {
+			t.Fatalf("%d: bad removed: %#v", i, removed)
+		}
+		if !reflect.DeepEqual(updated, tc.Updated) {
+			t.Fatalf("%d: bad updated: %#v", i, updated)
+		}
+	}
+}
+
+func TestKeyValueTagsServiceUpdate_error(t *testing.T) {
+	tagged := false
+	service := keyValueTagsService{
+		Tag: func(identifier string, tags keyValueTags) error {
+			tagged = true
+			return nil
+		},
+		Untag: func(identifier string, tags keyValueTags) error {
+			return errors.New("untag failed")
+		},
+	}
+
+	err := service.Update("example", map[string]interface{}{"foo": "bar"}, map[string]interface{}{"bar": "baz"})
+	if err == nil || err.Error() != "untag failed" {
+		t.Fatalf("expected untag error, got: %v", err)
+	}
+	if tagged {
+		t.Fatal("expected no tags to be created after untag error")
+	}
+}
+
+func TestKeyValueTagsServiceGet(t *testing.T) {
+	service := keyValueTagsService{
+		List: func(identifier string) (keyValueTags, error) {
+			return keyValueTags{
+				"aws:cloudformation:stack-name": "foo",
+				"Name":                          identifier,
+			}, nil
+		},
+	}
+
+	tags, err := service.Get("example")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if expected := (keyValueTags{"Name": "example"}); !reflect.DeepEqual(tags, expected) {
+		t.Fatalf("bad: %#v", tags)
+	}
+}
+
+// TestKeyValueTagsServiceConversions checks that every service's tags survive
+// the round trip through its SDK tag type, and that tags reserved by AWS are
+// dropped on the way in and on the way out.
+func TestKeyValueTagsServiceConversions(t *testing.T) {
+	cases := []struct {
+		Service   string
+		RoundTrip func(map[string]interface{}) map[string]string
+	}{
+		{"ACM", func(m map[string]interface{}) map[string]string { return tagsToMapACM(tagsFromMapACM(m)) }},
+		{"ACMPCA", func(m map[string]interface{}) map[string]string { return tagsToMapACMPCA(tagsFromMapACMPCA(m)) }},
+		{"Beanstalk", func(m map[string]interface{}) map[string]string { return tagsToMapBeanstalk(tagsFromMapBeanstalk(m)) }},
+		{"CloudFront", func(m map[string]interface{}) map[string]string { return tagsToMapCloudFront(tagsFromMapCloudFront(m)) }},
+		{"Cloudtrail", func(m map[string]interface{}) map[string]string { return tagsToMapCloudtrail(tagsFromMapCloudtrail(m)) }},
+		{"CodeBuild", func(m map[string]interface{}) map[string]string { return tagsToMapCodeBuild(tagsFromMapCodeBuild(m)) }},
+		{"DAX", func(m map[string]interface{}) map[string]string { return tagsToMapDax(tagsFromMapDax(m)) }},
+		{"DMS", func(m map[string]interface{}) map[string]string { return dmsTagsToMap(dmsTagsFromMap(m)) }},
+		{"DS", func(m map[string]interface{}) map[string]string { return tagsToMapDS(tagsFromMapDS(m)) }},
+		{"DX", func(m map[string]interface{}) map[string]string { return tagsToMapDX(tagsFromMapDX(m)) }},
+		{"DynamoDB", func(m map[string]interface{}) map[string]string { return tagsToMapDynamoDb(tagsFromMapDynamoDb(m)) }},
+		{"EC", func(m map[string]interface{}) map[string]string { return tagsToMapEC(tagsFromMapEC(m)) }},
+		{"EC2", func(m map[string]interface{}) map[string]string { return tagsToMap(tagsFromMap(m)) }},
+		{"EFS", func(m map[string]interface{}) map[string]string { return tagsToMapEFS(tagsFromMapEFS(m)) }},
+		{"ELB", func(m map[string]interface{}) map[string]string { return tagsToMapELB(tagsFromMapELB(m)) }},
+		{"ELBv2", func(m map[string]interface{}) map[string]string { return tagsToMapELBv2(tagsFromMapELBv2(m)) }},
+		{"EMR", func(m map[string]interface{}) map[string]string { return tagsToMapEMR(expandTags(m)) }},
+		{"ElasticsearchService", func(m map[string]interface{}) map[string]string {
+			return tagsToMapElasticsearchService(tagsFromMapElasticsearchService(m))
+		}},
+		{"Generic", func(m map[string]interface{}) map[string]string { return tagsToMapGeneric(tagsFromMapGeneric(m)) }},
+		{"Inspector", func(m map[string]interface{}) map[string]string { return tagsToMapInspector(tagsFromMapInspector(m)) }},
+		{"Kinesis", func(m map[string]interface{}) map[string]string { return tagsToMapKinesis(tagsFromMapKinesis(m)) }},
+		{"KMS", func(m map[string]interface{}) map[string]string { return tagsToMapKMS(tagsFromMapKMS(m)) }},
+		{"Neptune", func(m map[string]interface{}) map[string]string { return tagsToMapNeptune(tagsFromMapNeptune(m)) }},
+		{"RDS", func(m map[string]interface{}) map[string]string { return tagsToMapRDS(tagsFromMapRDS(m)) }},
+		{"Redshift", func(m map[string]interface{}) map[string]string { return tagsToMapRedshift(tagsFromMapRedshift(m)) }},
+		{"Route53", func(m map[string]interface{}) map[string]string { return tagsToMapR53(tagsFromMapR53(m)) }},
+		{"S3", func(m map[string]interface{}) map[string]string { return tagsToMapS3(tagsFromMapS3(m)) }},
+		{"SSM", func(m map[string]interface{}) map[string]string { return tagsToMapSSM(tagsFromMapSSM(m)) }},
+		{"SecretsManager", func(m map[string]interface{}) map[string]string {
+			return tagsToMapSecretsManager(tagsFromMapSecretsManager(m))
+		}},
+	}
+
+	in := map[string]interface{}{
+		"aws:cloudformation:logical-id": "foo",
+		"Environment":                   "Production",
+		"Empty":                         "",
+	}
+	expected := map[string]string{
+		"Environment": "Production",
+		"Empty":       "",
+	}
+
+	for _, tc := range cases {
+		if actual := tc.RoundTrip(in); !reflect.DeepEqual(actual, expected) {
+			t.Fatalf("%s: bad: %#v", tc.Service, actual)
+		}
+	}
+}
+
+func TestKeyValueTagsFromCloudFront_nil(t *testing.T) {
+	if tags := keyValueTagsFromCloudFront(nil); len(tags) != 0 {
+		t.Fatalf("bad: %#v", tags)
+	}
+	if tags := keyValueTagsFromCloudFront(&cloudfront.Tags{}); len(tags) != 0 {
+		t.Fatalf("bad: %#v", tags)
+	}
+}
@@ -0,0 +1,347 @@
+package aws
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+const (
+	// throttleBaseRetryDelay and throttleMaxRetryDelay bound the delay before
+	// retrying a throttled request, which doubles with every retry and with
+	// every throttled request of the service that has not since succeeded.
+	throttleBaseRetryDelay = 500 * time.Millisecond
+	throttleMaxRetryDelay  = 30 * time.Second
+
+	// rateLimitThrottledFactor is applied to the rate of a rate limited
+	// service each time one of its requests is throttled, down to
+	// rateLimitMinFactor of its configured rate. Each request that succeeds
+	// adds back rateLimitRecoveryFactor of the configured rate.
+	rateLimitThrottledFactor = 0.5
+	rateLimitMinFactor       = 0.1
+	rateLimitRecoveryFactor  = 0.05
+)
+
+// RateLimit is the rate at which requests are sent to a service.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+// rateLimitSchema returns the schema for the provider-level rate_limit
+// blocks.
+func rateLimitSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(endpointServiceNames, false),
+					Description:  descriptions["rate_limit_service"],
+				},
+				"requests_per_second": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					ValidateFunc: validateRequestsPerSecond,
+					Description:  descriptions["rate_limit_requests_per_second"],
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  descriptions["rate_limit_burst"],
+				},
+			},
+		},
+		Description: descriptions["rate_limit"],
+	}
+}
+
+func validateRequestsPerSecond(v interface{}, k string) (ws []string, errors []error) {
+	if value := v.(float64); value <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than 0, got: %g", k, value))
+	}
+	return
+}
+
+// expandRateLimits returns the provider rate_limit configuration keyed by
+// service.
+func expandRateLimits(l []interface{}) (map[string]*RateLimit, error) {
+	rateLimits := make(map[string]*RateLimit)
+	for _, raw := range l {
+		m, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		service := m["service"].(string)
+		if _, ok := rateLimits[service]; ok {
+			return nil, fmt.Errorf("rate_limit: service %q is configured more than once", service)
+		}
+		rateLimits[service] = &RateLimit{
+			RequestsPerSecond: m["requests_per_second"].(float64),
+			Burst:             m["burst"].(int),
+		}
+	}
+
+	return rateLimits, nil
+}
+
+// clientThrottling holds the adaptive retryers, and so the rate limiters and
+// retry metrics, shared by the clients of each service of an AWSClient.
+type clientThrottling struct {
+	rateLimits map[string]*RateLimit
+	maxRetries int
+	retryers   map[string]*adaptiveRetryer
+}
+
+func newClientThrottling(rateLimits map[string]*RateLimit, maxRetries int) *clientThrottling {
+	return &clientThrottling{
+		rateLimits: rateLimits,
+		maxRetries: maxRetries,
+		retryers:   make(map[string]*adaptiveRetryer),
+	}
+}
+
+// session returns a copy of sess for the clients of a service, with the
+// service endpoint, if any, and the adaptive retryer of the service.
+func (t *clientThrottling) session(sess *session.Session, service, endpoint string) *session.Session {
+	retryer, ok := t.retryers[service]
+	if !ok {
+		retryer = newAdaptiveRetryer(service, t.maxRetries, t.rateLimits[service])
+		t.retryers[service] = retryer
+	}
+
+	s := sess.Copy(request.WithRetryer(&aws.Config{Endpoint: aws.String(endpoint)}, retryer))
+	retryer.addHandlers(&s.Handlers)
+	return s
+}
+
+// adaptiveRetryer retries the requests of a service like the SDK default
+// retryer, except that throttled requests back off for longer the more the
+// service is being throttled, and slow down the rate limiter of the service.
+type adaptiveRetryer struct {
+	client.DefaultRetryer
+
+	service string
+	limiter *rateLimiter
+
+	baseDelay time.Duration
+	maxDelay  time.Duration
+
+	// throttled is the number of throttled requests since the last request
+	// of the service that succeeded.
+	throttled int32
+
+	requests  int64
+	retries   int64
+	throttles int64
+}
+
+// newAdaptiveRetryer returns the retryer of a service, rate limited when
+// rateLimit is not nil.
+func newAdaptiveRetryer(service string, maxRetries int, rateLimit *RateLimit) *adaptiveRetryer {
+	r := &adaptiveRetryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: maxRetries},
+		service:        service,
+		baseDelay:      throttleBaseRetryDelay,
+		maxDelay:       throttleMaxRetryDelay,
+	}
+	if rateLimit != nil {
+		r.limiter = newRateLimiter(rateLimit.RequestsPerSecond, rateLimit.Burst)
+	}
+	return r
+}
+
+// addHandlers adds the handlers that rate limit the requests of the service
+// and record its retry metrics.
+func (r *adaptiveRetryer) addHandlers(h *request.Handlers) {
+	if r.limiter != nil {
+		h.Send.PushFrontNamed(request.NamedHandler{
+			Name: "terraform.RateLimit",
+			Fn: func(req *request.Request) {
+				if err := r.limiter.Wait(req.Context()); err != nil {
+					req.Error = awserr.New(request.CanceledErrorCode, "request context canceled", err)
+					req.Retryable = aws.Bool(false)
+				}
+			},
+		})
+	}
+
+	h.Retry.PushBackNamed(request.NamedHandler{
+		Name: "terraform.AdaptiveRetry",
+		Fn: func(req *request.Request) {
+			if req.Error == nil || !isThrottlingError(req) {
+				return
+			}
+			atomic.AddInt64(&r.throttles, 1)
+			atomic.AddInt32(&r.throttled, 1)
+			if r.limiter != nil {
+				r.limiter.Throttled()
+			}
+		},
+	})
+
+	h.Complete.PushBackNamed(request.NamedHandler{
+		Name: "terraform.RetryMetrics",
+		Fn: func(req *request.Request) {
+			atomic.AddInt64(&r.requests, 1)
+			if req.Error != nil {
+				return
+			}
+			atomic.StoreInt32(&r.throttled, 0)
+			if r.limiter != nil {
+				r.limiter.Succeeded()
+			}
+		},
+	})
+}
+
+// RetryRules returns the delay before retrying a request.
+func (r *adaptiveRetryer) RetryRules(req *request.Request) time.Duration {
+	var delay time.Duration
+	var reason string
+
+	// Honour a Retry-After header through the default retryer
+	if isThrottlingError(req) && (req.HTTPResponse == nil || req.HTTPResponse.Header.Get("Retry-After") == "") {
+		exponent := req.RetryCount
+		if throttled := int(atomic.LoadInt32(&r.throttled)); throttled > exponent {
+			exponent = throttled
+		}
+
+		delay = r.maxDelay
+		if exponent < 16 {
+			if d := r.baseDelay << uint(exponent); d < delay {
+				delay = d
+			}
+		}
+		// Spread the retries of concurrent requests over the latter half of
+		// the delay.
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+		reason = "throttled"
+	} else {
+		delay = r.DefaultRetryer.RetryRules(req)
+		reason = "failed"
+	}
+
+	code := "error"
+	if err, ok := req.Error.(awserr.Error); ok {
+		code = err.Code()
+	}
+
+	retries := atomic.AddInt64(&r.retries, 1)
+	log.Printf("[DEBUG] Retrying %s %s in %s (retry %d of %d), request %s: %s; %s: %d requests, %d retries, %d throttled",
+		r.service, req.Operation.Name, delay, req.RetryCount+1, r.MaxRetries(), reason, code,
+		r.service, atomic.LoadInt64(&r.requests), retries, atomic.LoadInt64(&r.throttles))
+
+	return delay
+}
+
+// isThrottlingError returns whether a request failed because it was throttled.
+func isThrottlingError(req *request.Request) bool {
+	if req.HTTPResponse != nil && req.HTTPResponse.StatusCode == 429 {
+		return true
+	}
+	return req.IsErrorThrottle()
+}
+
+// rateLimiter is a token bucket that allows requests at a steady rate after an
+// initial burst. Its rate drops each time a request is throttled and recovers
+// as requests succeed.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	maxRate float64
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+
+	now func() time.Time
+}
+
+// newRateLimiter returns a rate limiter that allows requestsPerSecond requests
+// per second with bursts of up to burst requests, or of one second's worth of
+// requests when burst is zero.
+func newRateLimiter(requestsPerSecond float64, burst int) *rateLimiter {
+	b := float64(burst)
+	if b <= 0 {
+		b = math.Max(1, math.Ceil(requestsPerSecond))
+	}
+
+	l := &rateLimiter{
+		maxRate: requestsPerSecond,
+		rate:    requestsPerSecond,
+		burst:   b,
+		tokens:  b,
+		now:     time.Now,
+	}
+	l.last = l.now()
+	return l
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *rateLimiter) Wait(ctx aws.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	return aws.SleepWithContext(ctx, delay)
+}
+
+// reserve takes a token from the bucket and returns how long to wait until
+// it is available.
+func (l *rateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Rate returns the current rate in requests per second.
+func (l *rateLimiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// Throttled slows the rate down after a request was throttled.
+func (l *rateLimiter) Throttled() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = math.Max(l.maxRate*rateLimitMinFactor, l.rate*rateLimitThrottledFactor)
+}
+
+// Succeeded speeds the rate back up towards the configured rate after a
+// request succeeded.
+func (l *rateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rate = math.Min(l.maxRate, l.rate+l.maxRate*rateLimitRecoveryFactor)
+}
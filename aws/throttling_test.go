@@ -0,0 +1,217 @@
+package aws
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+	l.last = now
+
+	// The burst is available at once
+	for i := 0; i < 3; i++ {
+		if delay := l.reserve(); delay != 0 {
+			t.Fatalf("request %d: expected no delay, got %s", i, delay)
+		}
+	}
+
+	// Then requests are spaced out at the steady rate
+	if delay := l.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("expected 500ms delay, got %s", delay)
+	}
+	if delay := l.reserve(); delay != time.Second {
+		t.Fatalf("expected 1s delay, got %s", delay)
+	}
+
+	// Tokens refill over time, up to the burst
+	now = now.Add(time.Minute)
+	for i := 0; i < 3; i++ {
+		if delay := l.reserve(); delay != 0 {
+			t.Fatalf("request %d after refill: expected no delay, got %s", i, delay)
+		}
+	}
+	if delay := l.reserve(); delay != 500*time.Millisecond {
+		t.Fatalf("expected 500ms delay after refill, got %s", delay)
+	}
+}
+
+func TestRateLimiter_defaultBurst(t *testing.T) {
+	cases := []struct {
+		RequestsPerSecond float64
+		Burst             float64
+	}{
+		{0.5, 1},
+		{1, 1},
+		{2.5, 3},
+		{20, 20},
+	}
+
+	for _, tc := range cases {
+		if l := newRateLimiter(tc.RequestsPerSecond, 0); l.burst != tc.Burst {
+			t.Errorf("%g requests per second: expected burst %g, got %g", tc.RequestsPerSecond, tc.Burst, l.burst)
+		}
+	}
+}
+
+func TestRateLimiter_adaptiveRate(t *testing.T) {
+	l := newRateLimiter(10, 0)
+
+	l.Throttled()
+	if rate := l.Rate(); rate != 5 {
+		t.Fatalf("expected rate 5 after throttle, got %g", rate)
+	}
+
+	// The rate never drops below its floor
+	for i := 0; i < 10; i++ {
+		l.Throttled()
+	}
+	if rate := l.Rate(); rate != 1 {
+		t.Fatalf("expected rate 1 after repeated throttles, got %g", rate)
+	}
+
+	l.Succeeded()
+	if rate := l.Rate(); rate != 1.5 {
+		t.Fatalf("expected rate 1.5 after success, got %g", rate)
+	}
+
+	// Nor recovers past the configured rate
+	for i := 0; i < 100; i++ {
+		l.Succeeded()
+	}
+	if rate := l.Rate(); rate != 10 {
+		t.Fatalf("expected rate 10 after recovery, got %g", rate)
+	}
+}
+
+func TestAdaptiveRetryer_throttling(t *testing.T) {
+	var calls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/xml")
+		if atomic.AddInt32(&calls, 1) <= 2 {
+			w.WriteHeader(400)
+			w.Write([]byte(`<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`))
+			return
+		}
+		w.WriteHeader(200)
+		w.Write([]byte(stsResponse_GetCallerIdentity_valid))
+	}))
+	defer ts.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("accesskey", "secretkey", ""),
+		Region:      aws.String("us-east-1"),
+	})
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	throttling := newClientThrottling(map[string]*RateLimit{
+		"sts": {RequestsPerSecond: 100},
+	}, 5)
+	conn := sts.New(throttling.session(sess, "sts", ts.URL))
+
+	retryer := throttling.retryers["sts"]
+	retryer.baseDelay = time.Millisecond
+	retryer.maxDelay = 10 * time.Millisecond
+
+	if _, err := conn.GetCallerIdentity(&sts.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("error calling GetCallerIdentity: %s", err)
+	}
+
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+	if retryer.requests != 1 {
+		t.Errorf("expected 1 request, got %d", retryer.requests)
+	}
+	if retryer.retries != 2 {
+		t.Errorf("expected 2 retries, got %d", retryer.retries)
+	}
+	if retryer.throttles != 2 {
+		t.Errorf("expected 2 throttles, got %d", retryer.throttles)
+	}
+	if retryer.throttled != 0 {
+		t.Errorf("expected throttled to be reset after success, got %d", retryer.throttled)
+	}
+	// Halved twice, then recovered by one success
+	if rate := retryer.limiter.Rate(); rate != 30 {
+		t.Errorf("expected rate 30, got %g", rate)
+	}
+
+	// Clients of the same service share its retryer
+	throttling.session(sess, "sts", ts.URL)
+	if throttling.retryers["sts"] != retryer {
+		t.Errorf("expected sts retryer to be reused")
+	}
+}
+
+func TestExpandRateLimits(t *testing.T) {
+	expected := map[string]*RateLimit{
+		"ec2": {RequestsPerSecond: 20, Burst: 40},
+		"iam": {RequestsPerSecond: 2.5},
+	}
+
+	raw := map[string]interface{}{
+		"rate_limit": []interface{}{
+			map[string]interface{}{
+				"service":             "ec2",
+				"requests_per_second": 20.0,
+				"burst":               40,
+			},
+			map[string]interface{}{
+				"service":             "iam",
+				"requests_per_second": 2.5,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"rate_limit": rateLimitSchema()}, raw)
+
+	actual, err := expandRateLimits(d.Get("rate_limit").([]interface{}))
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("bad: %s", spew.Sdump(actual))
+	}
+}
+
+func TestExpandRateLimits_duplicateService(t *testing.T) {
+	raw := map[string]interface{}{
+		"rate_limit": []interface{}{
+			map[string]interface{}{
+				"service":             "ec2",
+				"requests_per_second": 20.0,
+			},
+			map[string]interface{}{
+				"service":             "ec2",
+				"requests_per_second": 10.0,
+			},
+		},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"rate_limit": rateLimitSchema()}, raw)
+
+	if _, err := expandRateLimits(d.Get("rate_limit").([]interface{})); err == nil {
+		t.Fatal("expected error for duplicate service")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/apigateway"
 	"github.com/aws/aws-sdk-go/service/cognitoidentity"
 	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
@@ -438,6 +439,12 @@ func validateAwsAccountId(v interface{}, k string) (ws []string, errors []error)
 	return
 }
 
+var (
+	arnPartitionRegexp = regexp.MustCompile(`^[\w-]+$`)
+	arnServiceRegexp   = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+	arnAccountIDRegexp = regexp.MustCompile(`^\d{12}$`)
+)
+
 func validateArn(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -445,17 +452,60 @@ func validateArn(v interface{}, k string) (ws []string, errors []error) {
 		return
 	}
 
-	// http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
-	pattern := `^arn:[\w-]+:([a-zA-Z0-9\-])+:([a-z]{2}-(gov-)?[a-z]+-\d{1})?:(\d{12})?:(.*)$`
-	if !regexp.MustCompile(pattern).MatchString(value) {
-		errors = append(errors, fmt.Errorf(
-			"%q doesn't look like a valid ARN (%q): %q",
-			k, pattern, value))
+	// https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html
+	a, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if !arnPartitionRegexp.MatchString(a.Partition) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition %q", k, value, a.Partition))
+	}
+	if !arnServiceRegexp.MatchString(a.Service) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid service %q", k, value, a.Service))
+	}
+	if a.Region != "" && !regionPattern.MatchString(a.Region) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid region %q", k, value, a.Region))
+	}
+	if a.AccountID != "" && !arnAccountIDRegexp.MatchString(a.AccountID) {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid account ID %q", k, value, a.AccountID))
 	}
 
 	return
 }
 
+// validateIamPolicyArn validates the ARN of an IAM managed policy, either
+// customer managed or AWS managed, such as
+// arn:aws:iam::aws:policy/ReadOnlyAccess, whose account ID is "aws".
+func validateIamPolicyArn(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if value == "" {
+		return
+	}
+
+	a, err := arn.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: %s", k, value, err))
+		return
+	}
+
+	if a.Service != "iam" || !strings.HasPrefix(a.Resource, "policy/") {
+		errors = append(errors, fmt.Errorf("%q (%s) is not the ARN of an IAM policy", k, value))
+	}
+
+	if a.AccountID == "aws" {
+		if !arnPartitionRegexp.MatchString(a.Partition) {
+			errors = append(errors, fmt.Errorf("%q (%s) is an invalid ARN: invalid partition %q", k, value, a.Partition))
+		}
+		return
+	}
+
+	w, es := validateArn(v, k)
+	return append(ws, w...), append(errors, es...)
+}
+
 func validatePolicyStatementId(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
@@ -1992,3 +2042,90 @@ func validateCloudFrontPublicKeyNamePrefix(v interface{}, k string) (ws []string
 	}
 	return
 }
+
+func validateDlmLifecyclePolicyDescription(v interface{}, k string) (ws []string, errors []error) {
+	// https://docs.aws.amazon.com/dlm/latest/APIReference/API_CreateLifecyclePolicy.html
+	value := v.(string)
+	if !regexp.MustCompile(`^[0-9A-Za-z _-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters, spaces, underscores and hyphens allowed in %q", k))
+	}
+	if len(value) > 500 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 500 characters", k))
+	}
+	return
+}
+
+func validateDlmCreateRuleInterval(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value != 12 && value != 24 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be 12 or 24, got: %d", k, value))
+	}
+	return
+}
+
+func validateDlmCreateRuleTime(v interface{}, k string) (ws []string, errors []error) {
+	// Times are given in UTC, in the hh:mm format
+	value := v.(string)
+	if !regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a time in the hh:mm format, got: %q", k, value))
+	}
+	return
+}
+
+func validateSagemakerName(v interface{}, k string) (ws []string, errors []error) {
+	// https://docs.aws.amazon.com/sagemaker/latest/dg/API_CreateModel.html#SageMaker-CreateModel-request-ModelName
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters and hyphens allowed in %q, and it must start and end with an alphanumeric character", k))
+	}
+	if len(value) > 63 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 63 characters", k))
+	}
+	return
+}
+
+func validateSagemakerVariantWeight(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(float64)
+	if value < 0 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be negative, got: %f", k, value))
+	}
+	return
+}
+
+func validateKinesisAnalyticsApplicationName(v interface{}, k string) (ws []string, errors []error) {
+	// https://docs.aws.amazon.com/kinesisanalytics/latest/dev/API_CreateApplication.html#analytics-CreateApplication-request-ApplicationName
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"only alphanumeric characters, underscores, hyphens and periods allowed in %q", k))
+	}
+	if len(value) > 128 {
+		errors = append(errors, fmt.Errorf(
+			"%q cannot be greater than 128 characters", k))
+	}
+	return
+}
+
+// validateLexName validates the names of Lex bots, bot aliases, intents and
+// slot types, which are made of letters separated by single underscores.
+func validateLexName(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if !regexp.MustCompile(`^([A-Za-z]_?)+$`).MatchString(value) {
+			errors = append(errors, fmt.Errorf(
+				"only letters and single underscores between them allowed in %q", k))
+		}
+		if len(value) < min || len(value) > max {
+			errors = append(errors, fmt.Errorf(
+				"%q must be between %d and %d characters, got: %d", k, min, max, len(value)))
+		}
+		return
+	}
+}
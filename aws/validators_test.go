@@ -317,6 +317,7 @@ func TestValidateArn(t *testing.T) {
 		"arn:aws:lambda:eu-west-1:319201112229:function:myCustomFunction:Qualifier",        // Lambda func qualifier
 		"arn:aws-us-gov:s3:::corp_bucket/object.png",                                       // GovCloud ARN
 		"arn:aws-us-gov:kms:us-gov-west-1:123456789012:key/some-uuid-abc123",               // GovCloud KMS ARN
+		"arn:aws-cn:ec2:cn-north-1:123456789012:instance/i-12345678",                       // China ARN
 	}
 	for _, v := range validNames {
 		_, errors := validateArn(v, "arn")
@@ -331,6 +332,9 @@ func TestValidateArn(t *testing.T) {
 		"arn:aws",
 		"arn:aws:logs",
 		"arn:aws:logs:region:*:*",
+		"arn:aws:iam::1234:user/David",
+		"arn::iam::123456789012:user/David",
+		"arn:aws:s3_bucket:::bucket",
 	}
 	for _, v := range invalidNames {
 		_, errors := validateArn(v, "arn")
@@ -340,6 +344,36 @@ func TestValidateArn(t *testing.T) {
 	}
 }
 
+func TestValidateIamPolicyArn(t *testing.T) {
+	validNames := []string{
+		"arn:aws:iam::aws:policy/ReadOnlyAccess",                  // AWS managed
+		"arn:aws:iam::aws:policy/service-role/AWSConfigRole",      // AWS managed, with path
+		"arn:aws:iam::123456789012:policy/example",                // Customer managed
+		"arn:aws-us-gov:iam::aws:policy/AdministratorAccess",      // GovCloud
+		"arn:aws-cn:iam::123456789012:policy/path/to/policy-name", // China, with path
+	}
+	for _, v := range validNames {
+		_, errors := validateIamPolicyArn(v, "policy_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid IAM policy ARN: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"ReadOnlyAccess",
+		"arn:aws:iam::aws:role/ReadOnlyAccess",
+		"arn:aws:iam::1234:policy/example",
+		"arn:aws:s3:::aws/policy/example",
+		"arn::iam::aws:policy/ReadOnlyAccess",
+	}
+	for _, v := range invalidNames {
+		_, errors := validateIamPolicyArn(v, "policy_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid IAM policy ARN", v)
+		}
+	}
+}
+
 func TestValidatePolicyStatementId(t *testing.T) {
 	validNames := []string{
 		"YadaHereAndThere",
@@ -2867,3 +2901,179 @@ func TestValidateDxConnectionBandWidth(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateDlmLifecyclePolicyDescription(t *testing.T) {
+	validDescriptions := []string{
+		"tf-acc-basic",
+		"Daily snapshots of the data volumes",
+		"snapshots_24h",
+	}
+	for _, v := range validDescriptions {
+		_, errors := validateDlmLifecyclePolicyDescription(v, "description")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid DLM Lifecycle Policy description: %q", v, errors)
+		}
+	}
+
+	invalidDescriptions := []string{
+		"",
+		"snapshots!",
+		"daily/weekly",
+		randomString(501),
+	}
+	for _, v := range invalidDescriptions {
+		_, errors := validateDlmLifecyclePolicyDescription(v, "description")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid DLM Lifecycle Policy description", v)
+		}
+	}
+}
+
+func TestValidateDlmCreateRuleInterval(t *testing.T) {
+	for _, v := range []int{12, 24} {
+		_, errors := validateDlmCreateRuleInterval(v, "interval")
+		if len(errors) != 0 {
+			t.Fatalf("%d should be a valid interval: %q", v, errors)
+		}
+	}
+
+	for _, v := range []int{0, 1, 6, 48} {
+		_, errors := validateDlmCreateRuleInterval(v, "interval")
+		if len(errors) == 0 {
+			t.Fatalf("%d should be an invalid interval", v)
+		}
+	}
+}
+
+func TestValidateDlmCreateRuleTime(t *testing.T) {
+	validTimes := []string{
+		"00:00",
+		"09:45",
+		"23:59",
+	}
+	for _, v := range validTimes {
+		_, errors := validateDlmCreateRuleTime(v, "times")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid time: %q", v, errors)
+		}
+	}
+
+	invalidTimes := []string{
+		"",
+		"9:45",
+		"24:00",
+		"12:60",
+		"12:00:00",
+		"noon",
+	}
+	for _, v := range invalidTimes {
+		_, errors := validateDlmCreateRuleTime(v, "times")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid time", v)
+		}
+	}
+}
+
+func TestValidateSagemakerName(t *testing.T) {
+	validNames := []string{
+		"ValidSageMakerName",
+		"Valid-5a63Mak3r-Name",
+		"123-456-789",
+		"1234",
+		strings.Repeat("W", 63),
+	}
+	for _, v := range validNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid SageMaker name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"Invalid name",
+		"-invalid-name",
+		"invalid-name-",
+		"invalid_name",
+		"invalid.name",
+		strings.Repeat("W", 64),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateSagemakerName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid SageMaker name", v)
+		}
+	}
+}
+
+func TestValidateSagemakerVariantWeight(t *testing.T) {
+	for _, v := range []float64{0, 0.5, 1, 100} {
+		_, errors := validateSagemakerVariantWeight(v, "initial_variant_weight")
+		if len(errors) != 0 {
+			t.Fatalf("%f should be a valid variant weight: %q", v, errors)
+		}
+	}
+
+	for _, v := range []float64{-0.1, -1} {
+		_, errors := validateSagemakerVariantWeight(v, "initial_variant_weight")
+		if len(errors) == 0 {
+			t.Fatalf("%f should be an invalid variant weight", v)
+		}
+	}
+}
+
+func TestValidateKinesisAnalyticsApplicationName(t *testing.T) {
+	validNames := []string{
+		"test",
+		"test-application_1.0",
+		strings.Repeat("W", 128),
+	}
+	for _, v := range validNames {
+		_, errors := validateKinesisAnalyticsApplicationName(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Kinesis Analytics Application name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"invalid name",
+		"invalid/name",
+		strings.Repeat("W", 129),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateKinesisAnalyticsApplicationName(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Kinesis Analytics Application name", v)
+		}
+	}
+}
+
+func TestValidateLexName(t *testing.T) {
+	validNames := []string{
+		"ab",
+		"OrderFlowers",
+		"Order_Flowers_",
+		strings.Repeat("W", 50),
+	}
+	for _, v := range validNames {
+		_, errors := validateLexName(2, 50)(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lex name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"a",
+		"_OrderFlowers",
+		"Order__Flowers",
+		"Order-Flowers",
+		"OrderFlowers1",
+		strings.Repeat("W", 51),
+	}
+	for _, v := range invalidNames {
+		_, errors := validateLexName(2, 50)(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Lex name", v)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// resourceStatusGone is the status given by a waiter with TargetGone set to
+// resources that are not found.
+const resourceStatusGone = "(gone)"
+
+// resourceWaiter waits for a resource to reach one of the Target statuses,
+// polling its status function while it is in one of the Pending statuses. The
+// waiters of each service are declared alongside the status functions of its
+// resources, in waiter_<service>.go.
+//
+// Status functions return a nil resource and an empty status, rather than an
+// error, when the resource is not found. The wait then ends once the resource
+// is not found if there are no Target statuses, as when waiting for a
+// deletion. Otherwise up to NotFoundChecks consecutive refreshes are allowed
+// not to find the resource, as a resource may not be visible yet right after
+// its creation.
+type resourceWaiter struct {
+	Pending []string
+	Target  []string
+
+	// TargetGone also ends the wait once the resource is not found, when there
+	// are Target statuses.
+	TargetGone bool
+
+	// Delay is the time to wait before the first refresh, and MinTimeout the
+	// shortest time to wait between refreshes. PollInterval, when set,
+	// replaces the exponential backoff between refreshes.
+	Delay        time.Duration
+	MinTimeout   time.Duration
+	PollInterval time.Duration
+
+	// Timeout, when set, replaces the timeout given to the waiter, e.g. to
+	// wait for milliseconds in unit tests.
+	Timeout time.Duration
+
+	// NotFoundChecks defaults to 20.
+	NotFoundChecks int
+
+	// ContinuousTargetOccurence is the number of consecutive refreshes which
+	// must find one of the Target statuses, for eventually consistent APIs.
+	ContinuousTargetOccurence int
+}
+
+// waitForResource waits for the resource of d, using the timeout of d for
+// the operation timeoutKey, such as schema.TimeoutCreate.
+func (w *resourceWaiter) waitForResource(d *schema.ResourceData, timeoutKey string, refresh resource.StateRefreshFunc) (interface{}, error) {
+	return w.wait(refresh, d.Timeout(timeoutKey))
+}
+
+// wait waits for a resource for up to timeout, returning the resource as
+// last returned by refresh.
+func (w *resourceWaiter) wait(refresh resource.StateRefreshFunc, timeout time.Duration) (interface{}, error) {
+	if w.Timeout > 0 {
+		timeout = w.Timeout
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:                   w.Pending,
+		Target:                    w.Target,
+		Refresh:                   refresh,
+		Timeout:                   timeout,
+		Delay:                     w.Delay,
+		MinTimeout:                w.MinTimeout,
+		PollInterval:              w.PollInterval,
+		NotFoundChecks:            w.NotFoundChecks,
+		ContinuousTargetOccurence: w.ContinuousTargetOccurence,
+	}
+
+	if w.TargetGone && len(w.Target) > 0 {
+		stateConf.Target = append([]string{resourceStatusGone}, w.Target...)
+		stateConf.Refresh = func() (interface{}, string, error) {
+			v, status, err := refresh()
+			if err == nil && v == nil {
+				return resourceStatusGone, resourceStatusGone, nil
+			}
+			return v, status, err
+		}
+	}
+
+	v, err := stateConf.WaitForState()
+	if v == resourceStatusGone {
+		v = nil
+	}
+	return v, err
+}
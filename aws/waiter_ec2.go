@@ -0,0 +1,46 @@
+package aws
+
+import (
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var ec2NatGatewayAvailableWaiter = &resourceWaiter{
+	Pending: []string{ec2.NatGatewayStatePending},
+	Target:  []string{ec2.NatGatewayStateAvailable},
+}
+
+var ec2NatGatewayDeletedWaiter = &resourceWaiter{
+	Pending:    []string{ec2.NatGatewayStateDeleting},
+	Target:     []string{ec2.NatGatewayStateDeleted},
+	TargetGone: true,
+	Delay:      10 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+func ec2NatGatewayStatus(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := conn.DescribeNatGateways(&ec2.DescribeNatGatewaysInput{
+			NatGatewayIds: []*string{aws.String(id)},
+		})
+		// Sometimes AWS just has consistency issues and doesn't see
+		// our NAT Gateway yet.
+		if isAWSErr(err, "NatGatewayNotFound", "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			log.Printf("Error on NAT Gateway (%s) status: %s", id, err)
+			return nil, "", err
+		}
+		if len(resp.NatGateways) == 0 {
+			return nil, "", nil
+		}
+
+		ng := resp.NatGateways[0]
+		return ng, aws.StringValue(ng.State), nil
+	}
+}
@@ -0,0 +1,42 @@
+package aws
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var eksClusterActiveWaiter = &resourceWaiter{
+	Pending: []string{eks.ClusterStatusCreating},
+	Target:  []string{eks.ClusterStatusActive},
+}
+
+var eksClusterDeletedWaiter = &resourceWaiter{
+	Pending: []string{
+		eks.ClusterStatusActive,
+		eks.ClusterStatusDeleting,
+	},
+}
+
+func eksClusterStatus(conn *eks.EKS, clusterName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeCluster(&eks.DescribeClusterInput{
+			Name: aws.String(clusterName),
+		})
+		if isAWSErr(err, eks.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+		// Sometimes the EKS API returns the ResourceNotFound error in this form:
+		// ClientException: No cluster found for name: tf-acc-test-0o1f8
+		if isAWSErr(err, eks.ErrCodeClientException, "No cluster found for name:") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if output.Cluster == nil {
+			return nil, "", nil
+		}
+		return output.Cluster, aws.StringValue(output.Cluster.Status), nil
+	}
+}
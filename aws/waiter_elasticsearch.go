@@ -0,0 +1,62 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// The Elasticsearch API has no domain status, so the status of a domain is
+// derived from its Processing and Deleted flags and endpoints.
+const (
+	elasticsearchDomainStatusActive     = "active"
+	elasticsearchDomainStatusDeleted    = "deleted"
+	elasticsearchDomainStatusProcessing = "processing"
+)
+
+var elasticsearchDomainActiveWaiter = &resourceWaiter{
+	Pending:    []string{elasticsearchDomainStatusProcessing},
+	Target:     []string{elasticsearchDomainStatusActive},
+	MinTimeout: 10 * time.Second,
+}
+
+var elasticsearchDomainDeletedWaiter = &resourceWaiter{
+	Pending:    []string{elasticsearchDomainStatusProcessing},
+	Target:     []string{elasticsearchDomainStatusDeleted},
+	TargetGone: true,
+	MinTimeout: 10 * time.Second,
+}
+
+func elasticsearchDomainStatus(conn *elasticsearch.ElasticsearchService, domainName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := conn.DescribeElasticsearchDomain(&elasticsearch.DescribeElasticsearchDomainInput{
+			DomainName: aws.String(domainName),
+		})
+		if isAWSErr(err, elasticsearch.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if out.DomainStatus == nil {
+			return nil, "", nil
+		}
+
+		return out.DomainStatus, elasticsearchDomainStatusFromDomain(out.DomainStatus), nil
+	}
+}
+
+func elasticsearchDomainStatusFromDomain(ds *elasticsearch.ElasticsearchDomainStatus) string {
+	switch {
+	case aws.BoolValue(ds.Processing):
+		return elasticsearchDomainStatusProcessing
+	case aws.BoolValue(ds.Deleted):
+		return elasticsearchDomainStatusDeleted
+	case ds.Endpoint == nil && ds.Endpoints == nil:
+		// Domains have an endpoint once they are created
+		return elasticsearchDomainStatusProcessing
+	}
+	return elasticsearchDomainStatusActive
+}
@@ -0,0 +1,43 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/fms"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// fmsAdminAccountReadyWaiter waits for the role of the admin account to be
+// created, which may only be reported a while after the association.
+var fmsAdminAccountReadyWaiter = &resourceWaiter{
+	Pending:    []string{fms.AccountRoleStatusCreating},
+	Target:     []string{fms.AccountRoleStatusReady},
+	Delay:      10 * time.Second,
+	MinTimeout: 5 * time.Second,
+}
+
+var fmsAdminAccountDeletedWaiter = &resourceWaiter{
+	Pending: []string{
+		fms.AccountRoleStatusDeleting,
+		fms.AccountRoleStatusPendingDeletion,
+		fms.AccountRoleStatusReady,
+	},
+	Target:     []string{fms.AccountRoleStatusDeleted},
+	TargetGone: true,
+	Delay:      10 * time.Second,
+	MinTimeout: 5 * time.Second,
+}
+
+func fmsAdminAccountStatus(conn *fms.FMS) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetAdminAccount(&fms.GetAdminAccountInput{})
+		if isAWSErr(err, fms.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return output, aws.StringValue(output.RoleStatus), nil
+	}
+}
@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kinesisanalytics"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var kinesisAnalyticsApplicationRunningWaiter = &resourceWaiter{
+	Pending: []string{
+		kinesisanalytics.ApplicationStatusReady,
+		kinesisanalytics.ApplicationStatusStarting,
+		kinesisanalytics.ApplicationStatusUpdating,
+	},
+	Target:     []string{kinesisanalytics.ApplicationStatusRunning},
+	Delay:      10 * time.Second,
+	MinTimeout: 5 * time.Second,
+}
+
+var kinesisAnalyticsApplicationReadyWaiter = &resourceWaiter{
+	Pending: []string{
+		kinesisanalytics.ApplicationStatusRunning,
+		kinesisanalytics.ApplicationStatusStopping,
+		kinesisanalytics.ApplicationStatusUpdating,
+	},
+	Target:     []string{kinesisanalytics.ApplicationStatusReady},
+	Delay:      10 * time.Second,
+	MinTimeout: 5 * time.Second,
+}
+
+// kinesisAnalyticsApplicationUpdatedWaiter waits for an update to be applied,
+// after which the application is back to its previous status.
+var kinesisAnalyticsApplicationUpdatedWaiter = &resourceWaiter{
+	Pending: []string{kinesisanalytics.ApplicationStatusUpdating},
+	Target: []string{
+		kinesisanalytics.ApplicationStatusReady,
+		kinesisanalytics.ApplicationStatusRunning,
+	},
+	MinTimeout: 5 * time.Second,
+}
+
+var kinesisAnalyticsApplicationDeletedWaiter = &resourceWaiter{
+	Pending: []string{
+		kinesisanalytics.ApplicationStatusDeleting,
+		kinesisanalytics.ApplicationStatusReady,
+		kinesisanalytics.ApplicationStatusRunning,
+		kinesisanalytics.ApplicationStatusStopping,
+	},
+	MinTimeout: 5 * time.Second,
+}
+
+func kinesisAnalyticsApplicationStatus(conn *kinesisanalytics.KinesisAnalytics, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeApplication(&kinesisanalytics.DescribeApplicationInput{
+			ApplicationName: aws.String(name),
+		})
+		if isAWSErr(err, kinesisanalytics.ErrCodeResourceNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if output.ApplicationDetail == nil {
+			return nil, "", nil
+		}
+		return output.ApplicationDetail, aws.StringValue(output.ApplicationDetail.ApplicationStatus), nil
+	}
+}
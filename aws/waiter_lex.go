@@ -0,0 +1,108 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/lexmodelbuildingservice"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// lexStatusCreated is the status given to Lex bot aliases, intents and slot
+// types, which have none, while they exist.
+const lexStatusCreated = "CREATED"
+
+// lexBotBuiltWaiter waits for the build of a bot, started when it is saved
+// with the BUILD process behavior, to complete or fail.
+var lexBotBuiltWaiter = &resourceWaiter{
+	Pending: []string{lexmodelbuildingservice.StatusBuilding},
+	Target: []string{
+		lexmodelbuildingservice.StatusFailed,
+		lexmodelbuildingservice.StatusNotBuilt,
+		lexmodelbuildingservice.StatusReady,
+		lexmodelbuildingservice.StatusReadyBasicTesting,
+	},
+	Delay:      5 * time.Second,
+	MinTimeout: 5 * time.Second,
+}
+
+var lexBotDeletedWaiter = &resourceWaiter{
+	Pending: []string{
+		lexmodelbuildingservice.StatusBuilding,
+		lexmodelbuildingservice.StatusFailed,
+		lexmodelbuildingservice.StatusNotBuilt,
+		lexmodelbuildingservice.StatusReady,
+		lexmodelbuildingservice.StatusReadyBasicTesting,
+	},
+	MinTimeout: 5 * time.Second,
+}
+
+var lexDeletedWaiter = &resourceWaiter{
+	Pending:    []string{lexStatusCreated},
+	MinTimeout: 5 * time.Second,
+}
+
+// lexBotStatus returns the build status of the $LATEST version of a bot.
+func lexBotStatus(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBot(&lexmodelbuildingservice.GetBotInput{
+			Name:           aws.String(name),
+			VersionOrAlias: aws.String(lexVersionLatest),
+		})
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func lexBotAliasStatus(conn *lexmodelbuildingservice.LexModelBuildingService, botName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetBotAlias(&lexmodelbuildingservice.GetBotAliasInput{
+			BotName: aws.String(botName),
+			Name:    aws.String(name),
+		})
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return output, lexStatusCreated, nil
+	}
+}
+
+func lexIntentStatus(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetIntent(&lexmodelbuildingservice.GetIntentInput{
+			Name:    aws.String(name),
+			Version: aws.String(lexVersionLatest),
+		})
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return output, lexStatusCreated, nil
+	}
+}
+
+func lexSlotTypeStatus(conn *lexmodelbuildingservice.LexModelBuildingService, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.GetSlotType(&lexmodelbuildingservice.GetSlotTypeInput{
+			Name:    aws.String(name),
+			Version: aws.String(lexVersionLatest),
+		})
+		if isAWSErr(err, lexmodelbuildingservice.ErrCodeNotFoundException, "") {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return output, lexStatusCreated, nil
+	}
+}
@@ -0,0 +1,132 @@
+package aws
+
+import (
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Database instance status: http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/Overview.DBInstance.Status.html
+var resourceAwsDbInstanceCreatePendingStates = []string{
+	"backing-up",
+	"configuring-enhanced-monitoring",
+	"configuring-log-exports",
+	"creating",
+	"maintenance",
+	"modifying",
+	"rebooting",
+	"renaming",
+	"resetting-master-credentials",
+	"starting",
+	"stopping",
+	"upgrading",
+}
+
+var resourceAwsDbInstanceDeletePendingStates = []string{
+	"available",
+	"backing-up",
+	"configuring-enhanced-monitoring",
+	"configuring-log-exports",
+	"creating",
+	"deleting",
+	"incompatible-parameters",
+	"modifying",
+	"starting",
+	"stopping",
+	"storage-full",
+	"storage-optimization",
+}
+
+var resourceAwsDbInstanceUpdatePendingStates = []string{
+	"backing-up",
+	"configuring-enhanced-monitoring",
+	"configuring-log-exports",
+	"creating",
+	"maintenance",
+	"modifying",
+	"moving-to-vpc",
+	"rebooting",
+	"renaming",
+	"resetting-master-credentials",
+	"starting",
+	"stopping",
+	"storage-full",
+	"upgrading",
+}
+
+var resourceAwsRdsClusterInstanceCreateUpdatePendingStates = []string{
+	"backing-up",
+	"configuring-enhanced-monitoring",
+	"configuring-log-exports",
+	"creating",
+	"maintenance",
+	"modifying",
+	"rebooting",
+	"renaming",
+	"resetting-master-credentials",
+	"starting",
+	"upgrading",
+}
+
+var resourceAwsRdsClusterInstanceDeletePendingStates = []string{
+	"configuring-log-exports",
+	"modifying",
+	"deleting",
+}
+
+var rdsDbInstanceAvailableAfterCreateWaiter = &resourceWaiter{
+	Pending:    resourceAwsDbInstanceCreatePendingStates,
+	Target:     []string{"available", "storage-optimization"},
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var rdsDbInstanceAvailableAfterUpdateWaiter = &resourceWaiter{
+	Pending:    resourceAwsDbInstanceUpdatePendingStates,
+	Target:     []string{"available", "storage-optimization"},
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var rdsDbInstanceDeletedWaiter = &resourceWaiter{
+	Pending:    resourceAwsDbInstanceDeletePendingStates,
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var rdsClusterInstanceAvailableWaiter = &resourceWaiter{
+	Pending:    resourceAwsRdsClusterInstanceCreateUpdatePendingStates,
+	Target:     []string{"available"},
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var rdsClusterInstanceDeletedWaiter = &resourceWaiter{
+	Pending:    resourceAwsRdsClusterInstanceDeletePendingStates,
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+// rdsDbInstanceStatus returns the status function of a DB instance, or of an
+// instance of an RDS cluster.
+func rdsDbInstanceStatus(conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := resourceAwsDbInstanceRetrieve(id, conn)
+
+		if err != nil {
+			log.Printf("Error on retrieving DB Instance when waiting: %s", err)
+			return nil, "", err
+		}
+
+		if v == nil {
+			return nil, "", nil
+		}
+
+		log.Printf("[DEBUG] DB Instance status for instance %s: %s", id, aws.StringValue(v.DBInstanceStatus))
+
+		return v, aws.StringValue(v.DBInstanceStatus), nil
+	}
+}
@@ -0,0 +1,111 @@
+package aws
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+var sagemakerNotebookInstanceInServiceWaiter = &resourceWaiter{
+	Pending: []string{
+		sagemaker.NotebookInstanceStatusPending,
+		sagemaker.NotebookInstanceStatusUpdating,
+	},
+	Target:     []string{sagemaker.NotebookInstanceStatusInService},
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var sagemakerNotebookInstanceStoppedWaiter = &resourceWaiter{
+	Pending: []string{
+		sagemaker.NotebookInstanceStatusInService,
+		sagemaker.NotebookInstanceStatusStopping,
+		sagemaker.NotebookInstanceStatusUpdating,
+	},
+	Target:     []string{sagemaker.NotebookInstanceStatusStopped},
+	Delay:      10 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var sagemakerNotebookInstanceDeletedWaiter = &resourceWaiter{
+	Pending: []string{
+		sagemaker.NotebookInstanceStatusDeleting,
+		sagemaker.NotebookInstanceStatusFailed,
+		sagemaker.NotebookInstanceStatusStopped,
+	},
+	Delay:      10 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+// sagemakerEndpointInServiceWaiter waits for the creation or update of an
+// endpoint to complete or fail. A failed update rolls the endpoint back to its
+// previous configuration, which is then in service again, so rolling back is
+// where the failure is detected.
+var sagemakerEndpointInServiceWaiter = &resourceWaiter{
+	Pending: []string{
+		sagemaker.EndpointStatusCreating,
+		sagemaker.EndpointStatusSystemUpdating,
+		sagemaker.EndpointStatusUpdating,
+	},
+	Target: []string{
+		sagemaker.EndpointStatusFailed,
+		sagemaker.EndpointStatusInService,
+		sagemaker.EndpointStatusRollingBack,
+	},
+	Delay:      30 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+var sagemakerEndpointDeletedWaiter = &resourceWaiter{
+	Pending:    []string{sagemaker.EndpointStatusDeleting},
+	Delay:      10 * time.Second,
+	MinTimeout: 10 * time.Second,
+}
+
+func sagemakerNotebookInstanceStatus(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+			NotebookInstanceName: aws.String(name),
+		})
+		if isSagemakerNotebookInstanceNotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if output == nil {
+			return nil, "", nil
+		}
+		return output, aws.StringValue(output.NotebookInstanceStatus), nil
+	}
+}
+
+func sagemakerEndpointStatus(conn *sagemaker.SageMaker, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := conn.DescribeEndpoint(&sagemaker.DescribeEndpointInput{
+			EndpointName: aws.String(name),
+		})
+		if isSagemakerEndpointNotFound(err) {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		if output == nil {
+			return nil, "", nil
+		}
+		return output, aws.StringValue(output.EndpointStatus), nil
+	}
+}
+
+// SageMaker reports most missing resources as validation errors, e.g.
+// ValidationException: RecordNotFound
+func isSagemakerNotebookInstanceNotFound(err error) bool {
+	return isAWSErr(err, "ValidationException", "RecordNotFound")
+}
+
+func isSagemakerEndpointNotFound(err error) bool {
+	return isAWSErr(err, "ValidationException", "Could not find endpoint")
+}
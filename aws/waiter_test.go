@@ -0,0 +1,226 @@
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	elasticsearch "github.com/aws/aws-sdk-go/service/elasticsearchservice"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// testResourceWaiter returns a copy of w polling every millisecond, for up
+// to a second.
+func testResourceWaiter(w *resourceWaiter) *resourceWaiter {
+	c := *w
+	c.Delay = 0
+	c.MinTimeout = 0
+	c.PollInterval = time.Millisecond
+	c.Timeout = time.Second
+	return &c
+}
+
+// testResourceStatuses returns a status function returning each of statuses
+// in turn, then the last one. An empty status is that of a resource not
+// found, and an error status makes the function fail.
+func testResourceStatuses(statuses ...string) resource.StateRefreshFunc {
+	i := 0
+	return func() (interface{}, string, error) {
+		status := statuses[i]
+		if i < len(statuses)-1 {
+			i++
+		}
+		switch status {
+		case "":
+			return nil, "", nil
+		case "error":
+			return nil, "", fmt.Errorf("refresh error")
+		}
+		return status, status, nil
+	}
+}
+
+func TestResourceWaiter(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Waiter   *resourceWaiter
+		Statuses []string
+		Expected interface{}
+		ErrCount int
+	}{
+		{
+			Name:     "target",
+			Waiter:   &resourceWaiter{Pending: []string{"creating"}, Target: []string{"available"}},
+			Statuses: []string{"creating", "creating", "available"},
+			Expected: "available",
+		},
+		{
+			Name:     "not found then found",
+			Waiter:   &resourceWaiter{Pending: []string{"creating"}, Target: []string{"available"}},
+			Statuses: []string{"", "", "creating", "available"},
+			Expected: "available",
+		},
+		{
+			Name:     "not found",
+			Waiter:   &resourceWaiter{Pending: []string{"creating"}, Target: []string{"available"}, NotFoundChecks: 2},
+			Statuses: []string{""},
+			ErrCount: 1,
+		},
+		{
+			Name:     "unexpected status",
+			Waiter:   &resourceWaiter{Pending: []string{"creating"}, Target: []string{"available"}},
+			Statuses: []string{"creating", "failed"},
+			ErrCount: 1,
+		},
+		{
+			Name:     "refresh error",
+			Waiter:   &resourceWaiter{Pending: []string{"creating"}, Target: []string{"available"}},
+			Statuses: []string{"creating", "error"},
+			ErrCount: 1,
+		},
+		{
+			Name:     "continuous target",
+			Waiter:   &resourceWaiter{Pending: []string{"creating"}, Target: []string{"available"}, ContinuousTargetOccurence: 2},
+			Statuses: []string{"available", "creating", "available", "available"},
+			Expected: "available",
+		},
+		{
+			Name:     "deleted",
+			Waiter:   &resourceWaiter{Pending: []string{"deleting"}},
+			Statuses: []string{"deleting", "deleting", ""},
+		},
+		{
+			Name:     "target gone",
+			Waiter:   &resourceWaiter{Pending: []string{"deleting"}, Target: []string{"deleted"}, TargetGone: true},
+			Statuses: []string{"deleting", ""},
+		},
+		{
+			Name:     "target before gone",
+			Waiter:   &resourceWaiter{Pending: []string{"deleting"}, Target: []string{"deleted"}, TargetGone: true},
+			Statuses: []string{"deleting", "deleted"},
+			Expected: "deleted",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := testResourceWaiter(tc.Waiter).wait(testResourceStatuses(tc.Statuses...), time.Minute)
+			if tc.ErrCount == 0 && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.ErrCount > 0 {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if v != tc.Expected {
+				t.Fatalf("expected %v, got %v", tc.Expected, v)
+			}
+		})
+	}
+}
+
+func TestResourceWaiter_waitForResource(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Millisecond),
+		},
+	}
+	w := &resourceWaiter{
+		Pending:      []string{"creating"},
+		Target:       []string{"available"},
+		PollInterval: time.Millisecond,
+	}
+
+	start := time.Now()
+	_, err := w.waitForResource(r.Data(nil), schema.TimeoutCreate, testResourceStatuses("creating"))
+	if _, ok := err.(*resource.TimeoutError); !ok {
+		t.Fatalf("expected a timeout error, got: %#v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the create timeout of the resource to be used, waited %s", elapsed)
+	}
+}
+
+func TestServiceResourceWaiters(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Waiter   *resourceWaiter
+		Statuses []string
+		ErrCount int
+	}{
+		{"DB instance created", rdsDbInstanceAvailableAfterCreateWaiter, []string{"", "creating", "backing-up", "available"}, 0},
+		{"DB instance storage optimization", rdsDbInstanceAvailableAfterCreateWaiter, []string{"creating", "storage-optimization"}, 0},
+		{"DB instance failed", rdsDbInstanceAvailableAfterCreateWaiter, []string{"creating", "failed"}, 1},
+		{"DB instance updated", rdsDbInstanceAvailableAfterUpdateWaiter, []string{"modifying", "moving-to-vpc", "available"}, 0},
+		{"DB instance deleted", rdsDbInstanceDeletedWaiter, []string{"available", "deleting", ""}, 0},
+		{"RDS cluster instance created", rdsClusterInstanceAvailableWaiter, []string{"creating", "available"}, 0},
+		{"RDS cluster instance deleted", rdsClusterInstanceDeletedWaiter, []string{"deleting", ""}, 0},
+		{"EKS cluster created", eksClusterActiveWaiter, []string{eks.ClusterStatusCreating, eks.ClusterStatusActive}, 0},
+		{"EKS cluster failed", eksClusterActiveWaiter, []string{eks.ClusterStatusCreating, eks.ClusterStatusFailed}, 1},
+		{"EKS cluster deleted", eksClusterDeletedWaiter, []string{eks.ClusterStatusActive, eks.ClusterStatusDeleting, ""}, 0},
+		{"NAT Gateway created", ec2NatGatewayAvailableWaiter, []string{"", ec2.NatGatewayStatePending, ec2.NatGatewayStateAvailable}, 0},
+		{"NAT Gateway failed", ec2NatGatewayAvailableWaiter, []string{ec2.NatGatewayStatePending, ec2.NatGatewayStateFailed}, 1},
+		{"NAT Gateway deleted", ec2NatGatewayDeletedWaiter, []string{ec2.NatGatewayStateDeleting, ec2.NatGatewayStateDeleted}, 0},
+		{"NAT Gateway gone", ec2NatGatewayDeletedWaiter, []string{ec2.NatGatewayStateDeleting, ""}, 0},
+		{"Elasticsearch domain created", elasticsearchDomainActiveWaiter, []string{"", elasticsearchDomainStatusProcessing, elasticsearchDomainStatusActive}, 0},
+		{"Elasticsearch domain deleted", elasticsearchDomainDeletedWaiter, []string{elasticsearchDomainStatusProcessing, elasticsearchDomainStatusDeleted}, 0},
+		{"Elasticsearch domain gone", elasticsearchDomainDeletedWaiter, []string{elasticsearchDomainStatusProcessing, ""}, 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := testResourceWaiter(tc.Waiter).wait(testResourceStatuses(tc.Statuses...), time.Minute)
+			if tc.ErrCount == 0 && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.ErrCount > 0 && err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestElasticsearchDomainStatusFromDomain(t *testing.T) {
+	cases := []struct {
+		Domain   *elasticsearch.ElasticsearchDomainStatus
+		Expected string
+	}{
+		{
+			Domain:   &elasticsearch.ElasticsearchDomainStatus{Processing: aws.Bool(true)},
+			Expected: elasticsearchDomainStatusProcessing,
+		},
+		{
+			Domain:   &elasticsearch.ElasticsearchDomainStatus{Processing: aws.Bool(false)},
+			Expected: elasticsearchDomainStatusProcessing,
+		},
+		{
+			Domain:   &elasticsearch.ElasticsearchDomainStatus{Processing: aws.Bool(false), Endpoint: aws.String("search-example.us-west-2.es.amazonaws.com")},
+			Expected: elasticsearchDomainStatusActive,
+		},
+		{
+			Domain:   &elasticsearch.ElasticsearchDomainStatus{Processing: aws.Bool(false), Endpoints: map[string]*string{"vpc": aws.String("vpc-example.us-west-2.es.amazonaws.com")}},
+			Expected: elasticsearchDomainStatusActive,
+		},
+		{
+			Domain:   &elasticsearch.ElasticsearchDomainStatus{Processing: aws.Bool(true), Deleted: aws.Bool(true)},
+			Expected: elasticsearchDomainStatusProcessing,
+		},
+		{
+			Domain:   &elasticsearch.ElasticsearchDomainStatus{Processing: aws.Bool(false), Deleted: aws.Bool(true)},
+			Expected: elasticsearchDomainStatusDeleted,
+		},
+	}
+
+	for i, tc := range cases {
+		if actual := elasticsearchDomainStatusFromDomain(tc.Domain); actual != tc.Expected {
+			t.Fatalf("%d: expected %q, got %q", i, tc.Expected, actual)
+		}
+	}
+}